@@ -0,0 +1,147 @@
+package signals
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// actor is an effect whose function runs on a dedicated goroutine. The
+// embedded effect tracks the dependencies; its own function only posts to
+// the mailbox.
+type actor struct {
+	*effect
+
+	// fn is the user function, only ever called by loop
+	fn func()
+
+	// mailbox holds pending runs; loop folds every token queued during a
+	// run into the next one
+	mailbox chan struct{}
+
+	// mu guards closing mailbox against concurrent posts
+	mu     sync.RWMutex
+	closed bool
+
+	// done is closed when loop returns
+	done chan struct{}
+
+	// goroutine is the ID of the goroutine running loop
+	goroutine atomic.Uint64
+
+	// fnStats counts runs of fn, rather than of the embedded effect
+	fnStats runStats
+}
+
+// EffectActor creates an effect that runs fn on its own goroutine instead
+// of the call stack of the Set that changed a dependency.
+//
+// Each dependency change posts to a mailbox holding up to mailboxSize
+// pending runs (at least 1), and a single goroutine drains it: fn never
+// runs concurrently with itself, and all changes that arrived while fn was
+// running are coalesced into one more run. Writers never block; once the
+// mailbox is full, changes fold into a run that is already pending.
+//
+// Like Effect, fn runs once right away (on the actor goroutine). Panics
+// in fn are recovered and logged. Stop unsubscribes from the dependencies,
+// lets a pending run finish, and waits for the goroutine to exit (unless
+// called from fn itself).
+//
+// Use it for effects doing I/O, which should not slow down writers.
+//
+// Example:
+//
+//	eff := signals.EffectActor(func() {
+//	    saveToDisk(settings.Get()) // Off the Set call stack
+//	}, 1, settings.AsReadonly())
+//	defer eff.Stop()
+func EffectActor(fn func(), mailboxSize int, deps ...any) EffectRef {
+	a := &actor{
+		fn:      fn,
+		mailbox: make(chan struct{}, max(mailboxSize, 1)),
+		done:    make(chan struct{}),
+	}
+	a.effect = newEffect(func() func() {
+		a.post()
+		return nil
+	}, EffectOptions{})
+
+	go a.loop()
+	a.start(deps)
+	return a
+}
+
+// post queues a run, unless the mailbox is full or closed.
+func (a *actor) post() {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	if a.closed {
+		return
+	}
+	select {
+	case a.mailbox <- struct{}{}:
+		settling.add(1)
+	default: // A run is pending already
+	}
+}
+
+// loop runs fn for each batch of mailbox tokens until the mailbox is closed.
+func (a *actor) loop() {
+	defer close(a.done)
+	a.goroutine.Store(goroutineID())
+
+	for range a.mailbox {
+		tokens := 1 + a.drain()
+		func() {
+			defer settling.done(tokens)
+			defer a.recoverPanic("effect actor")
+			a.fnStats.record(a.clock)
+			a.fn()
+		}()
+	}
+}
+
+// drain discards the tokens queued so far, returning how many: one run
+// covers them all.
+func (a *actor) drain() int {
+	for n := 0; ; n++ {
+		select {
+		case _, ok := <-a.mailbox:
+			if !ok {
+				return n
+			}
+		default:
+			return n
+		}
+	}
+}
+
+// RunCount returns how many times fn has run on the actor goroutine.
+func (a *actor) RunCount() int64 {
+	return a.fnStats.count()
+}
+
+// LastRunTime returns when the latest run of fn started.
+func (a *actor) LastRunTime() time.Time {
+	return a.fnStats.lastRun()
+}
+
+// Stop unsubscribes the actor, lets a pending run finish, and stops its goroutine.
+// Safe to call multiple times.
+func (a *actor) Stop() {
+	a.effect.Stop()
+
+	a.mu.Lock()
+	if a.closed {
+		a.mu.Unlock()
+		return
+	}
+	a.closed = true
+	close(a.mailbox)
+	a.mu.Unlock()
+
+	// fn stopping its own actor can't wait for itself
+	if goroutineID() != a.goroutine.Load() {
+		<-a.done
+	}
+}
@@ -0,0 +1,155 @@
+package signals
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// TestEffectActor_RunsOffTheWriter verifies fn runs initially, and on changes without blocking Set
+func TestEffectActor_RunsOffTheWriter(t *testing.T) {
+	count := New(0)
+	release := make(chan struct{})
+	var runs atomic.Int32
+	var last atomic.Int64
+
+	eff := EffectActor(func() {
+		if runs.Add(1) == 1 {
+			<-release // Hold the first run
+		}
+		last.Store(int64(count.Get()))
+	}, 1, count.AsReadonly())
+	defer eff.Stop()
+
+	waitFor(t, func() bool { return runs.Load() == 1 })
+
+	done := make(chan struct{})
+	go func() {
+		for i := 1; i <= 100; i++ {
+			count.Set(i)
+		}
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Set blocked while the actor was busy")
+	}
+
+	close(release)
+	waitFor(t, func() bool { return runs.Load() == 2 && last.Load() == 100 })
+	time.Sleep(10 * time.Millisecond)
+	if got := runs.Load(); got != 2 {
+		t.Errorf("runs = %d, want 2 (initial + one coalesced)", got)
+	}
+}
+
+// TestEffectActor_SerialRuns verifies concurrent changes never run fn concurrently
+func TestEffectActor_SerialRuns(t *testing.T) {
+	a, b := New(0), New(0)
+	var running, overlaps, runs atomic.Int32
+
+	eff := EffectActor(func() {
+		if running.Add(1) > 1 {
+			overlaps.Add(1)
+		}
+		runs.Add(1)
+		_ = a.Get() + b.Get()
+		time.Sleep(time.Millisecond)
+		running.Add(-1)
+	}, 4, a.AsReadonly(), b.AsReadonly())
+
+	var wg sync.WaitGroup
+	for i := range 50 {
+		wg.Add(2)
+		go func() { defer wg.Done(); a.Set(i) }()
+		go func() { defer wg.Done(); b.Set(i) }()
+	}
+	wg.Wait()
+	eff.Stop()
+
+	if overlaps.Load() != 0 {
+		t.Errorf("fn overlapped itself %d times", overlaps.Load())
+	}
+	if got := runs.Load(); got < 2 || got > 101 {
+		t.Errorf("runs = %d, want coalesced runs between 2 and 101", got)
+	}
+}
+
+// TestEffectActor_StopDrains verifies Stop lets a pending run finish, and no run starts after it
+func TestEffectActor_StopDrains(t *testing.T) {
+	count := New(0)
+	release := make(chan struct{})
+	var runs atomic.Int32
+	var last atomic.Int64
+
+	eff := EffectActor(func() {
+		if runs.Add(1) == 1 {
+			<-release
+		}
+		last.Store(int64(count.Get()))
+	}, 1, count.AsReadonly())
+
+	waitFor(t, func() bool { return runs.Load() == 1 })
+	count.Set(7) // Pending while the first run is held
+
+	stopped := make(chan struct{})
+	go func() {
+		eff.Stop()
+		close(stopped)
+	}()
+	close(release)
+	<-stopped
+
+	if got := last.Load(); got != 7 {
+		t.Errorf("last seen = %d, want the pending run to see 7", got)
+	}
+	count.Set(8)
+	time.Sleep(10 * time.Millisecond)
+	if got := runs.Load(); got != 2 {
+		t.Errorf("runs = %d after Stop, want 2", got)
+	}
+	eff.Stop() // Safe to call again
+}
+
+// TestEffectActor_StopFromFn verifies fn can stop its own actor without deadlocking
+func TestEffectActor_StopFromFn(t *testing.T) {
+	var eff EffectRef
+	ready := make(chan struct{})
+	done := make(chan struct{})
+
+	eff = EffectActor(func() {
+		<-ready
+		eff.Stop()
+		close(done)
+	}, 1)
+	close(ready)
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Stop from fn deadlocked")
+	}
+}
+
+// TestEffectActor_RunCount verifies RunCount counts runs of fn, not posts to the mailbox
+func TestEffectActor_RunCount(t *testing.T) {
+	count := New(0)
+	eff := EffectActor(func() {}, 1, count.AsReadonly())
+	defer eff.Stop()
+
+	if err := Settle(settleCtx(t)); err != nil {
+		t.Fatalf("Settle() = %v, want nil", err)
+	}
+	count.Set(1)
+	if err := Settle(settleCtx(t)); err != nil {
+		t.Fatalf("Settle() = %v, want nil", err)
+	}
+	if got := eff.RunCount(); got != 2 {
+		t.Errorf("RunCount() = %d, want 2", got)
+	}
+	if eff.LastRunTime().IsZero() {
+		t.Error("LastRunTime() is zero after runs")
+	}
+}
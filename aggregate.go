@@ -0,0 +1,166 @@
+package signals
+
+import (
+	"context"
+	"slices"
+	"sync"
+)
+
+// aggregateMember is a subscription to one member of an aggregated list.
+type aggregateMember struct {
+	// key identifies the member (see dependencyKey)
+	key any
+
+	// unsubscribe cancels the subscription
+	unsubscribe Unsubscribe
+}
+
+// aggregate is the internal implementation of Aggregate.
+type aggregate[T, R any] struct {
+	// list holds the current members
+	list ReadonlySignal[[]ReadonlySignal[T]]
+
+	// fold combines the member values
+	fold func([]T) R
+
+	// value holds the latest folded result
+	value *signal[R]
+
+	// listUnsub cancels the subscription to list
+	listUnsub Unsubscribe
+
+	// members are the current member subscriptions
+	members []aggregateMember
+
+	// stopped prevents resubscribing after Stop
+	stopped bool
+
+	// mu protects members and stopped, and serializes resubscribes
+	mu sync.Mutex
+}
+
+// Aggregate derives a signal from a dynamic list of signals: it holds
+// fold applied to the current value of every member, in list order.
+//
+// Unlike Computed, whose dependencies are fixed, Aggregate follows list
+// membership. When list changes, members no longer present are
+// unsubscribed and new ones are subscribed, then the result is refolded.
+// It also refolds whenever any current member changes.
+//
+// Call Stop to unsubscribe from the list and all members.
+//
+// Example:
+//
+//	carts := signals.New([]signals.ReadonlySignal[int]{a.AsReadonly()})
+//	total := signals.Aggregate(carts.AsReadonly(), func(vs []int) int {
+//	    sum := 0
+//	    for _, v := range vs {
+//	        sum += v
+//	    }
+//	    return sum
+//	})
+//	defer total.Stop()
+//
+//	carts.Update(func(cs []signals.ReadonlySignal[int]) []signals.ReadonlySignal[int] {
+//	    return append(slices.Clone(cs), b.AsReadonly()) // total now follows b too
+//	})
+func Aggregate[T, R any](list ReadonlySignal[[]ReadonlySignal[T]], fold func([]T) R) CombinedSignal[R] {
+	a := &aggregate[T, R]{list: list, fold: fold}
+	a.value = newSignal(a.collect(), Options[R]{})
+
+	a.listUnsub = trackDependentHelper(list, DependentComputed, reactionFunc(a.resubscribe))
+	a.resubscribe()
+	return a
+}
+
+// collect folds the current values of the current members.
+func (a *aggregate[T, R]) collect() R {
+	members := a.list.Get()
+	values := make([]T, len(members))
+	for i, m := range members {
+		values[i] = m.Get()
+	}
+	return a.fold(values)
+}
+
+// refold replaces the value after a change.
+// Collecting inside the transform serializes concurrent refolds,
+// so the last one to run always sees the latest values.
+func (a *aggregate[T, R]) refold() {
+	a.value.Update(func(R) R { return a.collect() })
+}
+
+// resubscribe aligns member subscriptions with the current list, then refolds.
+func (a *aggregate[T, R]) resubscribe() {
+	a.mu.Lock()
+	if a.stopped {
+		a.mu.Unlock()
+		return
+	}
+
+	current := a.list.Get()
+	keep := make([]aggregateMember, 0, len(current))
+	var stale []Unsubscribe
+	for _, m := range a.members {
+		if slices.ContainsFunc(current, func(s ReadonlySignal[T]) bool {
+			return sameDependency(m.key, dependencyKey(s))
+		}) {
+			keep = append(keep, m)
+		} else {
+			stale = append(stale, m.unsubscribe)
+		}
+	}
+	for _, s := range current {
+		key := dependencyKey(s)
+		if slices.ContainsFunc(keep, func(m aggregateMember) bool { return sameDependency(m.key, key) }) {
+			continue // Already subscribed (or listed twice)
+		}
+		unsub := trackDependentHelper(s, DependentComputed, reactionFunc(a.refold))
+		keep = append(keep, aggregateMember{key: key, unsubscribe: unsub})
+	}
+	a.members = keep
+	a.mu.Unlock()
+
+	for _, unsub := range stale {
+		unsub()
+	}
+	a.refold()
+}
+
+// Get returns the folded value.
+func (a *aggregate[T, R]) Get() R {
+	return a.value.Get()
+}
+
+// Subscribe registers a callback that receives each folded value.
+func (a *aggregate[T, R]) Subscribe(ctx context.Context, fn func(R)) Unsubscribe {
+	return a.value.Subscribe(ctx, fn)
+}
+
+// SubscribeForever registers a callback that never auto-cancels.
+func (a *aggregate[T, R]) SubscribeForever(fn func(R)) Unsubscribe {
+	return a.Subscribe(context.Background(), fn)
+}
+
+// subscribeDependent registers a downstream computed or effect as a dependent.
+func (a *aggregate[T, R]) subscribeDependent(kind DependentKind, r reaction) Unsubscribe {
+	return a.value.subscribeDependent(kind, r)
+}
+
+// Stop unsubscribes from the list and all members.
+func (a *aggregate[T, R]) Stop() {
+	a.mu.Lock()
+	if a.stopped {
+		a.mu.Unlock()
+		return
+	}
+	a.stopped = true
+	members := a.members
+	a.members = nil
+	a.mu.Unlock()
+
+	a.listUnsub()
+	for _, m := range members {
+		m.unsubscribe()
+	}
+}
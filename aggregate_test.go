@@ -0,0 +1,104 @@
+package signals
+
+import (
+	"slices"
+	"testing"
+)
+
+// sumInts folds member values into their sum.
+func sumInts(vs []int) int {
+	sum := 0
+	for _, v := range vs {
+		sum += v
+	}
+	return sum
+}
+
+// TestAggregate_TracksMembership verifies the aggregate follows added and removed members
+func TestAggregate_TracksMembership(t *testing.T) {
+	a, b := New(1), New(10)
+	list := New([]ReadonlySignal[int]{a.AsReadonly()})
+	total := Aggregate(list.AsReadonly(), sumInts)
+	defer total.Stop()
+
+	if got := total.Get(); got != 1 {
+		t.Fatalf("Get() = %d, want 1", got)
+	}
+
+	list.Set([]ReadonlySignal[int]{a.AsReadonly(), b.AsReadonly()})
+	if got := total.Get(); got != 11 {
+		t.Errorf("Get() after adding b = %d, want 11", got)
+	}
+
+	b.Set(20)
+	if got := total.Get(); got != 21 {
+		t.Errorf("Get() after b.Set = %d, want 21", got)
+	}
+
+	list.Set([]ReadonlySignal[int]{b.AsReadonly()})
+	if got := total.Get(); got != 20 {
+		t.Errorf("Get() after removing a = %d, want 20", got)
+	}
+}
+
+// TestAggregate_DropsStaleSubscriptions verifies removed members are unsubscribed
+func TestAggregate_DropsStaleSubscriptions(t *testing.T) {
+	a, b := New(1), New(2)
+	list := New([]ReadonlySignal[int]{a.AsReadonly(), b.AsReadonly()})
+	total := Aggregate(list.AsReadonly(), sumInts)
+	defer total.Stop()
+
+	var notified []int
+	total.SubscribeForever(func(v int) { notified = append(notified, v) })
+
+	list.Set([]ReadonlySignal[int]{b.AsReadonly()})
+	notified = nil
+
+	a.Set(100)
+	if len(notified) != 0 {
+		t.Errorf("removed member change notified %v, want nothing", notified)
+	}
+	if d := a.Dependents(); d.Total() != 0 {
+		t.Errorf("removed member Dependents() = %+v, want none", d)
+	}
+	if d := b.Dependents(); d.Computed != 1 {
+		t.Errorf("kept member Dependents().Computed = %d, want 1", d.Computed)
+	}
+}
+
+// TestAggregate_DuplicateMembers verifies a member listed twice is folded twice but subscribed once
+func TestAggregate_DuplicateMembers(t *testing.T) {
+	a := New(3)
+	list := New([]ReadonlySignal[int]{a.AsReadonly(), a.AsReadonly()})
+	total := Aggregate(list.AsReadonly(), sumInts)
+	defer total.Stop()
+
+	if got := total.Get(); got != 6 {
+		t.Errorf("Get() = %d, want 6", got)
+	}
+	if d := a.Dependents(); d.Computed != 1 {
+		t.Errorf("Dependents().Computed = %d, want 1", d.Computed)
+	}
+}
+
+// TestAggregate_Stop verifies Stop unsubscribes from the list and all members
+func TestAggregate_Stop(t *testing.T) {
+	a := New(1)
+	list := New([]ReadonlySignal[int]{a.AsReadonly()})
+	total := Aggregate(list.AsReadonly(), sumInts)
+
+	total.Stop()
+	total.Stop() // Safe to call twice
+
+	a.Set(5)
+	list.Update(func(l []ReadonlySignal[int]) []ReadonlySignal[int] {
+		return append(slices.Clone(l), New(7).AsReadonly())
+	})
+
+	if got := total.Get(); got != 1 {
+		t.Errorf("Get() after Stop = %d, want 1", got)
+	}
+	if a.Dependents().Total() != 0 || list.Dependents().Total() != 0 {
+		t.Error("Stop left dependent subscriptions behind")
+	}
+}
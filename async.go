@@ -0,0 +1,201 @@
+package signals
+
+import (
+	"context"
+	"log/slog"
+	"runtime/debug"
+	"sync"
+)
+
+// asyncComputed is the internal implementation of ComputedAsync.
+// Results are published through an internal signal, which holds the
+// last completed value and notifies subscribers when a new one lands.
+type asyncComputed[T any] struct {
+	// compute is the function that derives the value
+	compute func() T
+
+	// value holds the latest completed result
+	value *signal[T]
+
+	// mu guards generation, running, pending, and unsubscribes
+	mu sync.Mutex
+
+	// generation is incremented on every dependency change.
+	// A recompute only publishes if no change happened since it started.
+	generation uint64
+
+	// running is set while the worker goroutine recomputes; pending asks it
+	// for one more run, for changes that arrived meanwhile
+	running bool
+	pending bool
+
+	// onPanic is optional custom panic handler for compute
+	onPanic func(any, []byte)
+
+	// logger is the fallback for panics without onPanic (nil means package log)
+	logger *slog.Logger
+
+	// unsubscribes holds cleanup functions for dependencies
+	unsubscribes []Unsubscribe
+}
+
+// ComputedAsync creates a computed signal that recomputes in the background.
+//
+// The first value is computed synchronously. After that, Get never blocks on
+// compute: it returns the last completed result while a background goroutine
+// recomputes after a dependency change. When the recompute finishes, the new
+// value replaces the old one and subscribers are notified.
+//
+// If dependencies change again before a recompute finishes, its result is
+// stale and discarded; only the result of the latest change lands. At most
+// one recompute runs at a time: changes arriving meanwhile are folded into a
+// single follow-up run.
+//
+// Call Cleanup to unsubscribe from the dependencies once the signal is no
+// longer needed.
+//
+// Use this for expensive derivations where serving a slightly stale value is
+// preferable to blocking readers.
+//
+// Example:
+//
+//	query := signals.New("go")
+//	results := signals.ComputedAsync(func() []Result {
+//	    return search(query.Get()) // Slow
+//	}, query.AsReadonly())
+//
+//	defer results.Cleanup()
+//
+//	query.Set("golang")
+//	results.Get() // Still results for "go" until the search completes
+func ComputedAsync[T any](compute func() T, deps ...any) ComputedSignal[T] {
+	return ComputedAsyncWithOptions(compute, Options[T]{}, deps...)
+}
+
+// ComputedAsyncWithOptions creates an asynchronous computed signal with custom options.
+// OnPanic receives panics from compute (the previous value is kept) and from subscribers.
+func ComputedAsyncWithOptions[T any](compute func() T, opts Options[T], deps ...any) ComputedSignal[T] {
+	a := &asyncComputed[T]{
+		compute: compute,
+		onPanic: opts.OnPanic,
+		logger:  opts.Logger,
+	}
+
+	var initial T
+	if v, ok := a.run(); ok {
+		initial = v
+	}
+	a.value = newSignal(initial, Options[T]{Equal: opts.Equal, OnPanic: opts.OnPanic, Logger: opts.Logger})
+
+	a.mu.Lock()
+	for _, dep := range nonNilDependencies(deps, a.value.reportError) {
+		unsub := trackDependentHelper(dep, DependentComputed, reactionFunc(a.invalidate))
+		a.unsubscribes = append(a.unsubscribes, unsub)
+	}
+	a.mu.Unlock()
+
+	return a
+}
+
+// Cleanup unsubscribes from the dependencies. The last result is kept, and
+// a recompute in progress may still publish, but no new one starts.
+func (a *asyncComputed[T]) Cleanup() {
+	a.mu.Lock()
+	unsubscribes := a.unsubscribes
+	a.unsubscribes = nil
+	a.pending = false
+	a.mu.Unlock()
+
+	for _, unsub := range unsubscribes {
+		unsub()
+	}
+}
+
+// Get returns the latest completed result without waiting for a pending recompute.
+func (a *asyncComputed[T]) Get() T {
+	return a.value.Get()
+}
+
+// Subscribe registers a callback notified whenever a new result lands.
+func (a *asyncComputed[T]) Subscribe(ctx context.Context, fn func(T)) Unsubscribe {
+	return a.value.Subscribe(ctx, fn)
+}
+
+// SubscribeForever registers a callback that never auto-cancels.
+func (a *asyncComputed[T]) SubscribeForever(fn func(T)) Unsubscribe {
+	return a.value.SubscribeForever(fn)
+}
+
+// subscribeDependent registers a downstream computed or effect as a dependent.
+func (a *asyncComputed[T]) subscribeDependent(kind DependentKind, r reaction) Unsubscribe {
+	return a.value.subscribeDependent(kind, r)
+}
+
+// invalidate starts the background worker, or asks the running one to
+// recompute once more. Called when any dependency changes.
+func (a *asyncComputed[T]) invalidate() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.generation++
+	if a.running {
+		a.pending = true
+		return
+	}
+	a.running = true
+	settling.add(1)
+	go a.work()
+}
+
+// work recomputes until no change is pending, then exits.
+func (a *asyncComputed[T]) work() {
+	a.mu.Lock()
+	gen := a.generation
+	a.pending = false // Changes before the goroutine started are included
+	a.mu.Unlock()
+
+	for {
+		a.recompute(gen)
+
+		a.mu.Lock()
+		if !a.pending {
+			a.running = false
+			a.mu.Unlock()
+			settling.done(1)
+			return
+		}
+		a.pending = false
+		gen = a.generation
+		a.mu.Unlock()
+	}
+}
+
+// recompute computes and publishes a result unless dependencies changed
+// since gen, in which case a follow-up run is pending.
+func (a *asyncComputed[T]) recompute(gen uint64) {
+	v, ok := a.run()
+	if !ok {
+		return
+	}
+
+	a.mu.Lock()
+	stale := a.generation != gen
+	a.mu.Unlock()
+	if stale {
+		return // Dependencies changed while computing
+	}
+	a.value.Set(v)
+}
+
+// run calls compute with panic recovery. Reports false if compute panicked.
+func (a *asyncComputed[T]) run() (v T, ok bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			if a.onPanic != nil {
+				a.onPanic(r, debug.Stack())
+			} else {
+				logPanic(a.logger, "async computed function", r, debug.Stack())
+			}
+		}
+	}()
+	return a.compute(), true
+}
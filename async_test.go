@@ -0,0 +1,174 @@
+package signals
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// waitFor polls cond until it holds or the deadline passes.
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("condition not met before deadline")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+// TestComputedAsync_GetDoesNotBlock verifies Get returns the cached value while recomputing
+func TestComputedAsync_GetDoesNotBlock(t *testing.T) {
+	src := New(1)
+	release := make(chan struct{})
+	var blocking atomic.Bool
+
+	comp := ComputedAsync(func() int {
+		v := src.Get()
+		if blocking.Load() {
+			<-release
+		}
+		return v * 10
+	}, src.AsReadonly())
+
+	if got := comp.Get(); got != 10 {
+		t.Fatalf("Initial Get() = %d, want 10", got)
+	}
+
+	blocking.Store(true)
+	src.Set(2)
+
+	start := time.Now()
+	if got := comp.Get(); got != 10 {
+		t.Errorf("Get() during recompute = %d, want stale 10", got)
+	}
+	if elapsed := time.Since(start); elapsed > 50*time.Millisecond {
+		t.Errorf("Get() blocked for %v during recompute", elapsed)
+	}
+
+	close(release)
+	waitFor(t, func() bool { return comp.Get() == 20 })
+}
+
+// TestComputedAsync_OnlyLatestLands verifies stale recomputes are discarded
+// even when they finish after newer ones
+func TestComputedAsync_OnlyLatestLands(t *testing.T) {
+	src := New(0)
+
+	comp := ComputedAsync(func() int {
+		v := src.Get()
+		// Older values take longer, so they finish last
+		time.Sleep(time.Duration(10-v) * 3 * time.Millisecond)
+		return v
+	}, src.AsReadonly())
+
+	var mu sync.Mutex
+	var delivered []int
+	unsub := comp.SubscribeForever(func(v int) {
+		mu.Lock()
+		delivered = append(delivered, v)
+		mu.Unlock()
+	})
+	defer unsub()
+
+	for i := 1; i <= 5; i++ {
+		src.Set(i)
+	}
+
+	waitFor(t, func() bool { return comp.Get() == 5 })
+	time.Sleep(50 * time.Millisecond) // Let stale recomputes finish
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(delivered) != 1 || delivered[0] != 5 {
+		t.Errorf("delivered %v, want [5]", delivered)
+	}
+	if got := comp.Get(); got != 5 {
+		t.Errorf("Get() = %d after stale recomputes finished, want 5", got)
+	}
+}
+
+// TestComputedAsync_PanicKeepsValue verifies a panicking recompute keeps the previous value
+func TestComputedAsync_PanicKeepsValue(t *testing.T) {
+	src := New(1)
+	panicked := make(chan struct{}, 1)
+
+	comp := ComputedAsyncWithOptions(func() int {
+		v := src.Get()
+		if v < 0 {
+			panic("negative")
+		}
+		return v
+	}, Options[int]{
+		OnPanic: func(any, []byte) { panicked <- struct{}{} },
+	}, src.AsReadonly())
+
+	src.Set(-1)
+
+	select {
+	case <-panicked:
+	case <-time.After(time.Second):
+		t.Fatal("compute panic was not reported")
+	}
+	if got := comp.Get(); got != 1 {
+		t.Errorf("Get() after panic = %d, want 1", got)
+	}
+}
+
+// TestComputedAsync_OneRecomputeAtATime verifies a burst of changes runs compute serially, folding the burst into one follow-up run
+func TestComputedAsync_OneRecomputeAtATime(t *testing.T) {
+	src := New(0)
+	release := make(chan struct{})
+	var running, maxRunning, runs atomic.Int32
+
+	comp := ComputedAsync(func() int {
+		n := running.Add(1)
+		defer running.Add(-1)
+		if n > maxRunning.Load() {
+			maxRunning.Store(n)
+		}
+		runs.Add(1)
+		v := src.Get()
+		if v == 1 {
+			<-release // Hold the first recompute while the burst arrives
+		}
+		return v
+	}, src.AsReadonly())
+	defer comp.Cleanup()
+
+	src.Set(1)
+	waitFor(t, func() bool { return runs.Load() == 2 })
+	for i := 2; i <= 20; i++ {
+		src.Set(i)
+	}
+	close(release)
+
+	waitFor(t, func() bool { return comp.Get() == 20 })
+	if got := maxRunning.Load(); got != 1 {
+		t.Errorf("%d computes ran concurrently, want 1", got)
+	}
+	if got := runs.Load(); got != 3 {
+		t.Errorf("compute ran %d times, want 3 (initial, held, one follow-up)", got)
+	}
+}
+
+// TestComputedAsync_Cleanup verifies Cleanup releases the dependency subscriptions and stops recomputes
+func TestComputedAsync_Cleanup(t *testing.T) {
+	src := New(1)
+	comp := ComputedAsync(func() int { return src.Get() * 10 }, src.AsReadonly())
+	if d := src.Dependents(); d.Computed != 1 {
+		t.Fatalf("Dependents() = %+v before Cleanup, want one computed", d)
+	}
+
+	comp.Cleanup()
+	if d := src.Dependents(); d.Computed != 0 {
+		t.Errorf("Dependents() = %+v after Cleanup, want none", d)
+	}
+	src.Set(2)
+	time.Sleep(20 * time.Millisecond)
+	if got := comp.Get(); got != 10 {
+		t.Errorf("Get() = %d after Cleanup, want the last result 10", got)
+	}
+}
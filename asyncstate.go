@@ -0,0 +1,180 @@
+package signals
+
+import (
+	"context"
+	"fmt"
+)
+
+// AsyncStatus is the stage of an AsyncState.
+type AsyncStatus int
+
+const (
+	// AsyncIdle indicates nothing was loaded yet.
+	AsyncIdle AsyncStatus = iota
+
+	// AsyncLoading indicates a load is in progress.
+	AsyncLoading
+
+	// AsyncLoaded indicates the last load succeeded; Data holds its result.
+	AsyncLoaded
+
+	// AsyncFailed indicates the last load failed; Err holds the error.
+	AsyncFailed
+)
+
+// String returns a human-readable name for the status.
+func (s AsyncStatus) String() string {
+	switch s {
+	case AsyncIdle:
+		return "idle"
+	case AsyncLoading:
+		return "loading"
+	case AsyncLoaded:
+		return "loaded"
+	case AsyncFailed:
+		return "failed"
+	default:
+		return fmt.Sprintf("AsyncStatus(%d)", int(s))
+	}
+}
+
+// AsyncResult is the value of an AsyncState: its status along with the
+// latest data and error.
+//
+// Data is kept while reloading and after a failure, so a UI can keep
+// showing it (stale-while-revalidate). Err is nil unless Status is AsyncFailed.
+type AsyncResult[T any] struct {
+	Status AsyncStatus
+	Data   T
+	Err    error
+}
+
+// AsyncState is a signal tracking an asynchronous load through the
+// idle, loading, loaded, and failed stages.
+//
+// Subscribers receive the whole AsyncResult on every transition; the
+// Data, Err, and State views expose its parts for computeds and effects.
+// An effect depending on several views sees them consistent with each
+// other.
+//
+// Example:
+//
+//	user := signals.NewAsyncState[User]()
+//	go func() {
+//	    user.SetLoading()
+//	    u, err := fetchUser(id)
+//	    if err != nil {
+//	        user.SetError(err)
+//	        return
+//	    }
+//	    user.SetData(u)
+//	}()
+//
+//	signals.Effect(func() {
+//	    render(user.State().Get(), user.Data().Get())
+//	}, user.State(), user.Data())
+type AsyncState[T any] interface {
+	ReadonlySignal[AsyncResult[T]]
+
+	// SetLoading moves to AsyncLoading, clearing the error and keeping the data.
+	SetLoading()
+
+	// SetData moves to AsyncLoaded with value as the data.
+	SetData(value T)
+
+	// SetError moves to AsyncFailed with err, keeping the data.
+	SetError(err error)
+
+	// Data returns a read-only view of the latest data.
+	Data() ReadonlySignal[T]
+
+	// Err returns a read-only view of the error of the last failed load,
+	// or nil.
+	Err() ReadonlySignal[error]
+
+	// State returns a read-only view of the current status.
+	State() ReadonlySignal[AsyncStatus]
+}
+
+// asyncState is the internal implementation of AsyncState[T].
+// Transitions are written to a single signal, so the status, data,
+// and error always change together.
+type asyncState[T any] struct {
+	// result holds the current stage
+	result *signal[AsyncResult[T]]
+
+	// data, err, and status are views of result
+	data   ReadonlySignal[T]
+	err    ReadonlySignal[error]
+	status ReadonlySignal[AsyncStatus]
+}
+
+// NewAsyncState creates an AsyncState in the AsyncIdle stage.
+func NewAsyncState[T any]() AsyncState[T] {
+	return NewAsyncStateWithOptions(Options[AsyncResult[T]]{})
+}
+
+// NewAsyncStateWithOptions creates an AsyncState with custom options
+// applied to the underlying signal (e.g., OnPanic).
+func NewAsyncStateWithOptions[T any](opts Options[AsyncResult[T]]) AsyncState[T] {
+	a := &asyncState[T]{result: newSignal(AsyncResult[T]{}, opts)}
+	src := a.result.AsReadonly()
+	a.data = Computed(func() T { return a.result.Get().Data }, src)
+	a.err = Computed(func() error { return a.result.Get().Err }, src)
+	a.status = Computed(func() AsyncStatus { return a.result.Get().Status }, src)
+	return a
+}
+
+// Get returns the current stage.
+func (a *asyncState[T]) Get() AsyncResult[T] {
+	return a.result.Get()
+}
+
+// Subscribe registers a callback notified on every transition.
+func (a *asyncState[T]) Subscribe(ctx context.Context, fn func(AsyncResult[T])) Unsubscribe {
+	return a.result.Subscribe(ctx, fn)
+}
+
+// SubscribeForever registers a callback that never auto-cancels.
+func (a *asyncState[T]) SubscribeForever(fn func(AsyncResult[T])) Unsubscribe {
+	return a.result.SubscribeForever(fn)
+}
+
+// subscribeDependent registers a downstream computed or effect as a dependent.
+func (a *asyncState[T]) subscribeDependent(kind DependentKind, r reaction) Unsubscribe {
+	return a.result.subscribeDependent(kind, r)
+}
+
+// SetLoading moves to AsyncLoading, clearing the error and keeping the data.
+func (a *asyncState[T]) SetLoading() {
+	a.result.Update(func(r AsyncResult[T]) AsyncResult[T] {
+		return AsyncResult[T]{Status: AsyncLoading, Data: r.Data}
+	})
+}
+
+// SetData moves to AsyncLoaded with value as the data.
+func (a *asyncState[T]) SetData(value T) {
+	a.result.Set(AsyncResult[T]{Status: AsyncLoaded, Data: value})
+}
+
+// SetError moves to AsyncFailed with err, keeping the data.
+func (a *asyncState[T]) SetError(err error) {
+	a.result.Update(func(r AsyncResult[T]) AsyncResult[T] {
+		return AsyncResult[T]{Status: AsyncFailed, Data: r.Data, Err: err}
+	})
+}
+
+// Data returns a read-only view of the latest data.
+func (a *asyncState[T]) Data() ReadonlySignal[T] {
+	return a.data
+}
+
+// Err returns a read-only view of the error of the last failed load.
+func (a *asyncState[T]) Err() ReadonlySignal[error] {
+	return a.err
+}
+
+// State returns a read-only view of the current status.
+func (a *asyncState[T]) State() ReadonlySignal[AsyncStatus] {
+	return a.status
+}
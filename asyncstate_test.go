@@ -0,0 +1,75 @@
+package signals
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+)
+
+// TestAsyncState_LoadingToData verifies the Loading -> Loaded transition updates the state and views together
+func TestAsyncState_LoadingToData(t *testing.T) {
+	user := NewAsyncState[string]()
+	if got := user.State().Get(); got != AsyncIdle {
+		t.Fatalf("initial State = %v, want idle", got)
+	}
+
+	var seen []string
+	eff := Effect(func() {
+		seen = append(seen, fmt.Sprintf("%v %q %v", user.State().Get(), user.Data().Get(), user.Err().Get()))
+	}, user.State(), user.Data(), user.Err())
+	defer eff.Stop()
+
+	user.SetLoading()
+	user.SetData("ada")
+
+	want := []string{`idle "" <nil>`, `loading "" <nil>`, `loaded "ada" <nil>`}
+	if !slices.Equal(seen, want) {
+		t.Errorf("effect saw %q, want %q", seen, want)
+	}
+	if got := user.Get(); got.Status != AsyncLoaded || got.Data != "ada" || got.Err != nil {
+		t.Errorf("Get() = %+v, want loaded ada", got)
+	}
+}
+
+// TestAsyncState_LoadingToError verifies a failed reload keeps the data and exposes the error
+func TestAsyncState_LoadingToError(t *testing.T) {
+	errFetch := errors.New("fetch failed")
+	user := NewAsyncState[string]()
+	user.SetData("ada")
+
+	var statuses []AsyncStatus
+	unsub := user.SubscribeForever(func(r AsyncResult[string]) { statuses = append(statuses, r.Status) })
+	defer unsub()
+
+	user.SetLoading()
+	user.SetError(errFetch)
+
+	if want := []AsyncStatus{AsyncLoading, AsyncFailed}; !slices.Equal(statuses, want) {
+		t.Errorf("statuses = %v, want %v", statuses, want)
+	}
+	if got := user.State().Get(); got != AsyncFailed {
+		t.Errorf("State = %v, want failed", got)
+	}
+	if got := user.Err().Get(); !errors.Is(got, errFetch) {
+		t.Errorf("Err = %v, want %v", got, errFetch)
+	}
+	if got := user.Data().Get(); got != "ada" {
+		t.Errorf("Data = %q, want the previous data kept", got)
+	}
+
+	user.SetLoading()
+	if got := user.Err().Get(); got != nil {
+		t.Errorf("Err after SetLoading = %v, want nil", got)
+	}
+}
+
+// TestAsyncStatus_String verifies status names
+func TestAsyncStatus_String(t *testing.T) {
+	if got := AsyncLoading.String(); got != "loading" {
+		t.Errorf("AsyncLoading.String() = %q", got)
+	}
+	if got := AsyncStatus(9).String(); got != "AsyncStatus(9)" {
+		t.Errorf("AsyncStatus(9).String() = %q", got)
+	}
+}
@@ -0,0 +1,127 @@
+package signals
+
+import (
+	"slices"
+	"time"
+)
+
+// autoResetSignal is a signal that reverts to a default value once no
+// write has happened for a while.
+type autoResetSignal[T any] struct {
+	*signal[T]
+
+	revertTo T
+	after    time.Duration
+
+	// opts are the caller's Options, kept for Fork
+	opts  Options[T]
+	clock Clock
+
+	// timer fires expire; deadline is when the latest write expires,
+	// reverting marks the write made by expire, and armed is set while a
+	// revert is pending (counted by Settle). Guarded by signal.mu.
+	timer     Timer
+	deadline  time.Time
+	reverting bool
+	armed     bool
+}
+
+// NewAutoReset creates a writable signal that reverts to revertTo once
+// after has passed without a write.
+//
+// Every write (re)starts the countdown; when it expires the signal is set to
+// revertTo and subscribers are notified like for any other write. The
+// initial value does not start a countdown. Countdowns run on a timer,
+// not a goroutine; Close cancels a pending revert.
+//
+// Optional Options (only the first is used) configure the signal, e.g.,
+// Clock to drive the countdown from a FakeClock in tests.
+//
+// Use it for transient state such as "Copied!" toasts or hover highlights.
+//
+// Example:
+//
+//	status := signals.NewAutoReset("", "", 2*time.Second)
+//	defer status.Close()
+//
+//	status.Set("Copied!") // Back to "" after 2s without another Set
+func NewAutoReset[T any](initial T, revertTo T, after time.Duration, opts ...Options[T]) Signal[T] {
+	o := firstOptions(opts)
+	r := &autoResetSignal[T]{revertTo: revertTo, after: after, opts: o, clock: clockOrReal(o.Clock)}
+
+	// Interceptors run under the signal lock for every write,
+	// which orders countdown restarts with the writes themselves
+	o.Interceptors = append(slices.Clip(o.Interceptors), r.restart)
+	r.signal = newSignal(initial, o)
+	return r
+}
+
+// restart (re)starts the countdown for a write. Caller must hold signal.mu.
+func (r *autoResetSignal[T]) restart(_, value T) T {
+	if r.reverting {
+		r.reverting = false
+		return value
+	}
+
+	r.deadline = r.clock.Now().Add(r.after)
+	if !r.armed {
+		r.armed = true
+		settling.add(1)
+	}
+	if r.timer == nil {
+		r.timer = r.clock.AfterFunc(r.after, r.expire)
+	} else {
+		r.timer.Reset(r.after)
+	}
+	return value
+}
+
+// expire reverts the value, unless a write moved the deadline after the
+// timer fired.
+func (r *autoResetSignal[T]) expire() {
+	expired := false
+	_ = r.apply(func(T) (T, bool) {
+		var zero T
+		if r.clock.Now().Before(r.deadline) {
+			return zero, false // Restarted meanwhile; the timer fires again
+		}
+		r.reverting = true
+		expired, r.armed = r.armed, false
+		return r.revertTo, true
+	}, nil)
+	if expired {
+		settling.done(1) // Once the revert is delivered
+	}
+}
+
+// Close cancels a pending revert, then closes the signal.
+func (r *autoResetSignal[T]) Close() {
+	r.mu.Lock()
+	if r.timer != nil {
+		r.timer.Stop()
+	}
+	if r.armed {
+		r.armed = false
+		settling.done(1)
+	}
+	r.mu.Unlock()
+
+	r.signal.Close()
+}
+
+// CloseWith delivers a final value, then closes the signal without reverting.
+func (r *autoResetSignal[T]) CloseWith(final T) {
+	r.Set(final)
+	r.Close()
+}
+
+// AsReadonly returns a read-only view of the signal.
+func (r *autoResetSignal[T]) AsReadonly() ReadonlySignal[T] {
+	return newReadonly[T](r)
+}
+
+// Fork returns an independent auto-resetting signal with the current value.
+// The fork starts without a pending revert.
+func (r *autoResetSignal[T]) Fork() Signal[T] {
+	return NewAutoReset(r.Get(), r.revertTo, r.after, r.opts)
+}
@@ -0,0 +1,85 @@
+package signals
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// TestAutoReset_Reverts verifies the value reverts and notifies after inactivity
+func TestAutoReset_Reverts(t *testing.T) {
+	status := NewAutoReset("", "idle", 20*time.Millisecond)
+	defer status.Close()
+
+	var last atomic.Value
+	status.SubscribeForever(func(v string) { last.Store(v) })
+
+	status.Set("copied")
+	if got := status.Get(); got != "copied" {
+		t.Fatalf("Get() = %q, want copied", got)
+	}
+
+	waitFor(t, func() bool { return status.Get() == "idle" })
+	if got := last.Load(); got != "idle" {
+		t.Errorf("last notification = %v, want idle", got)
+	}
+}
+
+// TestAutoReset_SetRestartsCountdown verifies a second Set cancels the pending revert
+func TestAutoReset_SetRestartsCountdown(t *testing.T) {
+	status := NewAutoReset("", "", 60*time.Millisecond)
+	defer status.Close()
+
+	status.Set("first")
+	time.Sleep(40 * time.Millisecond)
+	status.Set("second")
+	time.Sleep(40 * time.Millisecond)
+
+	// 80ms after the first Set, but only 40ms after the second
+	if got := status.Get(); got != "second" {
+		t.Errorf("Get() = %q, want second", got)
+	}
+	waitFor(t, func() bool { return status.Get() == "" })
+}
+
+// TestAutoReset_InitialDoesNotRevert verifies the initial value is not counted as a write
+func TestAutoReset_InitialDoesNotRevert(t *testing.T) {
+	status := NewAutoReset("start", "", 10*time.Millisecond)
+	defer status.Close()
+
+	time.Sleep(30 * time.Millisecond)
+	if got := status.Get(); got != "start" {
+		t.Errorf("Get() = %q, want start", got)
+	}
+}
+
+// TestAutoReset_CloseCancelsRevert verifies Close stops a pending revert
+func TestAutoReset_CloseCancelsRevert(t *testing.T) {
+	status := NewAutoReset("", "", 10*time.Millisecond)
+	var notifications atomic.Int32
+	status.SubscribeForever(func(string) { notifications.Add(1) })
+
+	status.Set("copied")
+	status.Close()
+	time.Sleep(30 * time.Millisecond)
+
+	if got := status.Get(); got != "copied" {
+		t.Errorf("Get() = %q, want copied", got)
+	}
+	if got := notifications.Load(); got != 1 {
+		t.Errorf("notifications = %d, want 1", got)
+	}
+}
+
+// TestAutoReset_SetWithMetaReverts verifies a write with meta starts the countdown like Set
+func TestAutoReset_SetWithMetaReverts(t *testing.T) {
+	clock := NewFakeClock(time.Now())
+	status := NewAutoReset("", "", time.Second, Options[string]{Clock: clock})
+	defer status.Close()
+
+	status.(MetaSetter[string]).SetWithMeta("Saved", "autosave")
+	clock.Advance(time.Second)
+	if got := status.Get(); got != "" {
+		t.Errorf("Get() = %q after the countdown, want reverted", got)
+	}
+}
@@ -0,0 +1,69 @@
+package signals
+
+import "context"
+
+// Bus is a typed publish/subscribe channel for events.
+//
+// Unlike a Signal, a Bus holds no current value: events are delivered to
+// the subscribers registered at the time of Publish, and a late subscriber
+// does not see earlier events. Every Publish is delivered, including one
+// equal to the previous event.
+//
+// Example:
+//
+//	logins := signals.NewBus[string]()
+//	unsub := logins.SubscribeForever(func(user string) {
+//	    fmt.Println("login:", user)
+//	})
+//	defer unsub()
+//
+//	logins.Publish("alice")
+//	logins.Publish("alice") // Delivered again
+type Bus[T any] interface {
+	// Publish delivers event to all current subscribers.
+	Publish(event T)
+
+	// Subscribe registers a callback for events published until ctx is
+	// canceled or the returned Unsubscribe is called.
+	Subscribe(ctx context.Context, fn func(T)) Unsubscribe
+
+	// SubscribeForever registers a callback that will never be automatically canceled.
+	SubscribeForever(fn func(T)) Unsubscribe
+}
+
+// bus is the internal implementation of Bus.
+// Subscribers, delivery ordering, and panic recovery are those of a signal;
+// events are never stored as its value.
+type bus[T any] struct {
+	events *signal[T]
+}
+
+// NewBus creates an event bus.
+func NewBus[T any]() Bus[T] {
+	var zero T
+	return &bus[T]{events: newSignal(zero, Options[T]{})}
+}
+
+// Publish delivers event to all current subscribers, in publish order.
+// An event published from a subscriber is delivered after the current one.
+func (b *bus[T]) Publish(event T) {
+	s := b.events
+	s.mu.Lock()
+	callbacks := s.snapshotSubscribers()
+	deliver := s.beginNotify(callbacks, event, nil)
+	s.mu.Unlock()
+
+	if deliver {
+		s.deliver(callbacks, event, nil, nil)
+	}
+}
+
+// Subscribe registers a callback for published events.
+func (b *bus[T]) Subscribe(ctx context.Context, fn func(T)) Unsubscribe {
+	return b.events.Subscribe(ctx, fn)
+}
+
+// SubscribeForever registers a callback that never auto-cancels.
+func (b *bus[T]) SubscribeForever(fn func(T)) Unsubscribe {
+	return b.events.SubscribeForever(fn)
+}
@@ -0,0 +1,75 @@
+package signals
+
+import (
+	"context"
+	"slices"
+	"testing"
+)
+
+// TestBus_DeliversEveryPublish verifies every event reaches every subscriber, duplicates included
+func TestBus_DeliversEveryPublish(t *testing.T) {
+	b := NewBus[string]()
+	var first, second []string
+	b.SubscribeForever(func(e string) { first = append(first, e) })
+	b.SubscribeForever(func(e string) { second = append(second, e) })
+
+	b.Publish("a")
+	b.Publish("a")
+	b.Publish("b")
+
+	want := []string{"a", "a", "b"}
+	if !slices.Equal(first, want) || !slices.Equal(second, want) {
+		t.Errorf("received %v and %v, want %v each", first, second, want)
+	}
+}
+
+// TestBus_LateSubscriber verifies a late subscriber sees only later events
+func TestBus_LateSubscriber(t *testing.T) {
+	b := NewBus[int]()
+	b.Publish(1)
+
+	var got []int
+	b.SubscribeForever(func(e int) { got = append(got, e) })
+	b.Publish(2)
+
+	if !slices.Equal(got, []int{2}) {
+		t.Errorf("late subscriber received %v, want [2]", got)
+	}
+}
+
+// TestBus_Unsubscribe verifies canceled subscriptions stop receiving events
+func TestBus_Unsubscribe(t *testing.T) {
+	b := NewBus[int]()
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var viaCtx, viaUnsub int
+	b.Subscribe(ctx, func(int) { viaCtx++ })
+	unsub := b.SubscribeForever(func(int) { viaUnsub++ })
+
+	b.Publish(1)
+	cancel()
+	unsub()
+
+	// Context cancellation unsubscribes asynchronously
+	waitFor(t, func() bool { return subscriberCount(b.(*bus[int]).events) == 0 })
+	b.Publish(2)
+
+	if viaCtx != 1 || viaUnsub != 1 {
+		t.Errorf("received %d and %d events, want 1 each", viaCtx, viaUnsub)
+	}
+}
+
+// TestBus_PanicRecovery verifies a panicking subscriber doesn't stop delivery to others
+func TestBus_PanicRecovery(t *testing.T) {
+	b := NewBus[int]()
+	var got []int
+	b.SubscribeForever(func(int) { panic("boom") })
+	b.SubscribeForever(func(e int) { got = append(got, e) })
+
+	b.Publish(1)
+	b.Publish(2)
+
+	if !slices.Equal(got, []int{1, 2}) {
+		t.Errorf("received %v, want [1 2]", got)
+	}
+}
@@ -0,0 +1,127 @@
+package signals
+
+import (
+	"context"
+	"sync"
+)
+
+// FromChannel returns a signal fed by a channel: a goroutine receives from
+// ch and sets the signal to each value, until ctx is done or ch is closed.
+//
+// Get returns initial until the first value is received. After the pump
+// stops, the signal keeps its last value. Cancel ctx or close ch when the
+// signal is no longer needed, otherwise the goroutine runs forever.
+//
+// Example:
+//
+//	ctx, cancel := context.WithCancel(context.Background())
+//	defer cancel()
+//
+//	temps := make(chan float64)
+//	go readSensor(temps)
+//
+//	temp := signals.FromChannel(ctx, temps, 0)
+//	temp.SubscribeForever(func(v float64) {
+//	    fmt.Println("Temperature:", v)
+//	})
+func FromChannel[T any](ctx context.Context, ch <-chan T, initial T) ReadonlySignal[T] {
+	s := New(initial)
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case v, ok := <-ch:
+				if !ok {
+					return
+				}
+				s.Set(v)
+			}
+		}
+	}()
+
+	return s.AsReadonly()
+}
+
+// When returns a channel that receives the first value of s matching
+// pred, then closes. If the current value already matches, it is
+// delivered right away. It is the channel flavor of a one-off
+// SubscribeWhere, for waiting on a state in a select.
+//
+// The channel is buffered, so delivery never blocks the notification, and
+// the subscription ends with it. If s never matches, the subscription
+// lasts as long as s does.
+//
+// Example:
+//
+//	select {
+//	case <-signals.When(status.AsReadonly(), func(s string) bool { return s == "ready" }):
+//	    serve()
+//	case <-time.After(5 * time.Second):
+//	    return errors.New("not ready in time")
+//	}
+func When[T any](s ReadonlySignal[T], pred func(T) bool) <-chan T {
+	w := &when[T]{ch: make(chan T, 1)}
+	if v := s.Get(); pred(v) {
+		w.deliver(v)
+		return w.ch
+	}
+
+	unsub := s.SubscribeForever(func(v T) {
+		if pred(v) {
+			w.deliver(v)
+		}
+	})
+	if !w.setUnsubscribe(unsub) {
+		unsub() // Delivered before the subscription was in place
+		return w.ch
+	}
+
+	// A change between the first Get and Subscribe wasn't seen
+	if v := s.Get(); pred(v) {
+		w.deliver(v)
+	}
+	return w.ch
+}
+
+// when is the state of a When channel.
+type when[T any] struct {
+	ch chan T
+
+	// mu protects done and unsub
+	mu    sync.Mutex
+	done  bool
+	unsub Unsubscribe
+}
+
+// deliver sends v and closes the channel, unless already done, then
+// ends the subscription if it is in place.
+func (w *when[T]) deliver(v T) {
+	w.mu.Lock()
+	if w.done {
+		w.mu.Unlock()
+		return
+	}
+	w.done = true
+	w.ch <- v
+	close(w.ch)
+	unsub := w.unsub
+	w.mu.Unlock()
+
+	if unsub != nil {
+		unsub()
+	}
+}
+
+// setUnsubscribe records the subscription to end on delivery. It returns
+// false if delivery already happened, leaving unsub to the caller.
+func (w *when[T]) setUnsubscribe(unsub Unsubscribe) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.done {
+		return false
+	}
+	w.unsub = unsub
+	return true
+}
@@ -0,0 +1,133 @@
+package signals
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"testing"
+)
+
+// TestFromChannel_ReflectsValues verifies received values are set on the signal in order
+func TestFromChannel_ReflectsValues(t *testing.T) {
+	ch := make(chan int)
+	sig := FromChannel(context.Background(), ch, -1)
+
+	if got := sig.Get(); got != -1 {
+		t.Errorf("Get() before first receive = %d, want -1", got)
+	}
+
+	var mu sync.Mutex
+	var got []int
+	defer sig.SubscribeForever(func(v int) {
+		mu.Lock()
+		got = append(got, v)
+		mu.Unlock()
+	})()
+
+	for i := 1; i <= 3; i++ {
+		ch <- i
+	}
+	close(ch)
+
+	waitFor(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(got) == 3
+	})
+	mu.Lock()
+	defer mu.Unlock()
+	for i, v := range got {
+		if v != i+1 {
+			t.Errorf("delivered %v, want [1 2 3]", got)
+			break
+		}
+	}
+	if v := sig.Get(); v != 3 {
+		t.Errorf("Get() = %d, want 3", v)
+	}
+}
+
+// TestFromChannel_StopsPump verifies closing the channel or canceling ctx ends the goroutine
+func TestFromChannel_StopsPump(t *testing.T) {
+	before := runtime.NumGoroutine()
+
+	closed := make(chan int)
+	FromChannel(context.Background(), closed, 0)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	FromChannel(ctx, make(chan int), 0)
+
+	close(closed)
+	cancel()
+
+	waitFor(t, func() bool { return runtime.NumGoroutine() <= before })
+}
+
+// TestWhen_AlreadyMatching verifies a matching current value is delivered right away, without subscribing
+func TestWhen_AlreadyMatching(t *testing.T) {
+	sig := New(5)
+	ch := When(sig.AsReadonly(), func(v int) bool { return v > 3 })
+
+	select {
+	case v := <-ch:
+		if v != 5 {
+			t.Errorf("received %d, want 5", v)
+		}
+	default:
+		t.Fatal("nothing delivered for a matching current value")
+	}
+	if _, ok := <-ch; ok {
+		t.Error("channel not closed after delivery")
+	}
+	if n := subscriberCount(sig); n != 0 {
+		t.Errorf("%d subscribers left, want 0", n)
+	}
+}
+
+// TestWhen_LaterMatching verifies only the first matching change is delivered, then the channel closes and unsubscribes
+func TestWhen_LaterMatching(t *testing.T) {
+	sig := New(0)
+	ch := When(sig.AsReadonly(), func(v int) bool { return v%2 == 1 })
+
+	sig.Set(2)
+	select {
+	case v := <-ch:
+		t.Fatalf("received %d before a match", v)
+	default:
+	}
+
+	sig.Set(3)
+	sig.Set(5)
+	if v := <-ch; v != 3 {
+		t.Errorf("received %d, want 3 (the first match)", v)
+	}
+	if _, ok := <-ch; ok {
+		t.Error("channel not closed after delivery")
+	}
+	if n := subscriberCount(sig); n != 0 {
+		t.Errorf("%d subscribers left, want 0", n)
+	}
+}
+
+// TestWhen_Concurrent verifies concurrent writers deliver exactly once
+func TestWhen_Concurrent(t *testing.T) {
+	sig := New(0)
+	ch := When(sig.AsReadonly(), func(v int) bool { return v > 0 })
+
+	var wg sync.WaitGroup
+	for i := 1; i <= 8; i++ {
+		wg.Go(func() { sig.Set(i) })
+	}
+	wg.Wait()
+
+	received := 0
+	for range ch {
+		received++
+	}
+	if received != 1 {
+		t.Errorf("received %d values, want 1", received)
+	}
+	if n := subscriberCount(sig); n != 0 {
+		t.Errorf("%d subscribers left, want 0", n)
+	}
+}
@@ -0,0 +1,170 @@
+package signals
+
+import (
+	"slices"
+	"sync"
+	"time"
+)
+
+// Clock is the time source of time-based operators: RateLimit (through
+// RateLimitOptions.Clock), debounced effects (EffectOptions.Clock), and
+// NewAutoReset, CoalesceWindow, and MaxAge (Options.Clock). If nil, they
+// use the real clock.
+//
+// Inject a FakeClock to test time-based code deterministically, without
+// sleeping.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+
+	// AfterFunc arranges for f to be called once d has elapsed, like
+	// time.AfterFunc.
+	AfterFunc(d time.Duration, f func()) Timer
+}
+
+// Timer is a timer started by Clock.AfterFunc. *time.Timer implements it.
+type Timer interface {
+	// Stop prevents the timer from firing, reporting whether it was pending.
+	Stop() bool
+
+	// Reset makes the timer fire after d instead, reporting whether it was
+	// pending.
+	Reset(d time.Duration) bool
+}
+
+// realClock is the Clock backed by package time.
+type realClock struct{}
+
+func (realClock) Now() time.Time { return time.Now() }
+
+func (realClock) AfterFunc(d time.Duration, f func()) Timer { return time.AfterFunc(d, f) }
+
+// clockOrReal returns c, or the real clock if c is nil.
+func clockOrReal(c Clock) Clock {
+	if c == nil {
+		return realClock{}
+	}
+	return c
+}
+
+// FakeClock is a Clock whose time only moves when told to, for tests.
+// Timers fire synchronously during Advance, on the goroutine calling it,
+// so once Advance returns, everything due has run.
+//
+// The zero value is not usable; create one with NewFakeClock. A FakeClock
+// is safe for concurrent use.
+//
+// Example:
+//
+//	clock := signals.NewFakeClock(time.Now())
+//	search := signals.RateLimit(query.AsReadonly(), 300*time.Millisecond,
+//	    signals.RateLimitOptions{Trailing: true, Clock: clock})
+//
+//	query.Set("golang")
+//	clock.Advance(300 * time.Millisecond) // search.Get() is "golang"
+type FakeClock struct {
+	// mu protects now and timers
+	mu  sync.Mutex
+	now time.Time
+
+	// timers holds the pending timers
+	timers []*fakeTimer
+}
+
+// NewFakeClock creates a FakeClock set to start.
+func NewFakeClock(start time.Time) *FakeClock {
+	return &FakeClock{now: start}
+}
+
+// Now returns the fake current time.
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// AfterFunc schedules f to run once the clock has been advanced by d.
+func (c *FakeClock) AfterFunc(d time.Duration, f func()) Timer {
+	t := &fakeTimer{clock: c, fn: f}
+	t.Reset(d)
+	return t
+}
+
+// Advance moves the clock forward by d, firing the timers due on the way
+// in deadline order. The clock reads each timer's deadline while it runs,
+// and timers it starts fire too if due within d.
+func (c *FakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	target := c.now.Add(d)
+	c.mu.Unlock()
+
+	for {
+		c.mu.Lock()
+		t := c.nextDue(target)
+		if t == nil {
+			c.now = target
+			c.mu.Unlock()
+			return
+		}
+		c.now = t.when
+		t.removeLocked()
+		c.mu.Unlock()
+
+		t.fn() // Unlocked, so it may use the clock
+	}
+}
+
+// Pending returns the number of timers that haven't fired or been stopped.
+func (c *FakeClock) Pending() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.timers)
+}
+
+// nextDue returns the earliest timer due by target, or nil if there is
+// none. Caller must hold mu.
+func (c *FakeClock) nextDue(target time.Time) *fakeTimer {
+	var next *fakeTimer
+	for _, t := range c.timers {
+		if !t.when.After(target) && (next == nil || t.when.Before(next.when)) {
+			next = t
+		}
+	}
+	return next
+}
+
+// fakeTimer is a Timer of a FakeClock.
+type fakeTimer struct {
+	clock *FakeClock
+	fn    func()
+
+	// when is the deadline, guarded by clock.mu
+	when time.Time
+}
+
+// Stop removes the timer from its clock.
+func (t *fakeTimer) Stop() bool {
+	c := t.clock
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return t.removeLocked()
+}
+
+// Reset (re)schedules the timer d after the clock's current time.
+func (t *fakeTimer) Reset(d time.Duration) bool {
+	c := t.clock
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	pending := t.removeLocked()
+	t.when = c.now.Add(d)
+	c.timers = append(c.timers, t)
+	return pending
+}
+
+// removeLocked unschedules the timer, reporting whether it was pending.
+// Caller must hold clock.mu.
+func (t *fakeTimer) removeLocked() bool {
+	n := len(t.clock.timers)
+	t.clock.timers = slices.DeleteFunc(t.clock.timers, func(p *fakeTimer) bool { return p == t })
+	return len(t.clock.timers) < n
+}
@@ -0,0 +1,131 @@
+package signals
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+// TestFakeClock_Timers verifies timers fire during Advance in deadline order, and Stop and Reset reschedule them
+func TestFakeClock_Timers(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := NewFakeClock(start)
+
+	var fired []string
+	var firedAt []time.Duration
+	record := func(name string) func() {
+		return func() {
+			fired = append(fired, name)
+			firedAt = append(firedAt, clock.Now().Sub(start))
+		}
+	}
+	clock.AfterFunc(30*time.Millisecond, record("c"))
+	clock.AfterFunc(10*time.Millisecond, record("a"))
+	stopped := clock.AfterFunc(20*time.Millisecond, record("stopped"))
+	reset := clock.AfterFunc(5*time.Millisecond, record("reset"))
+
+	if !stopped.Stop() {
+		t.Error("Stop() = false for a pending timer")
+	}
+	if !reset.Reset(40 * time.Millisecond) {
+		t.Error("Reset() = false for a pending timer")
+	}
+	if n := clock.Pending(); n != 3 {
+		t.Errorf("Pending() = %d, want 3", n)
+	}
+
+	clock.Advance(35 * time.Millisecond)
+	if want := []string{"a", "c"}; !slices.Equal(fired, want) {
+		t.Errorf("fired %v after 35ms, want %v", fired, want)
+	}
+	if want := []time.Duration{10 * time.Millisecond, 30 * time.Millisecond}; !slices.Equal(firedAt, want) {
+		t.Errorf("fired at %v, want %v (each timer sees its deadline)", firedAt, want)
+	}
+	if got := clock.Now().Sub(start); got != 35*time.Millisecond {
+		t.Errorf("Now() = start+%v, want start+35ms", got)
+	}
+
+	clock.Advance(5 * time.Millisecond)
+	if want := []string{"a", "c", "reset"}; !slices.Equal(fired, want) {
+		t.Errorf("fired %v after 40ms, want %v", fired, want)
+	}
+	if stopped.Stop() {
+		t.Error("Stop() = true for a stopped timer")
+	}
+}
+
+// TestFakeClock_RateLimitDebounce verifies a trailing RateLimit emits once the fake clock passes the window
+func TestFakeClock_RateLimitDebounce(t *testing.T) {
+	clock := NewFakeClock(time.Now())
+	query := New("")
+	search := RateLimit(query.AsReadonly(), 300*time.Millisecond, RateLimitOptions{Trailing: true, Clock: clock})
+	defer search.Stop()
+
+	var emitted []string
+	search.SubscribeForever(func(q string) { emitted = append(emitted, q) })
+
+	query.Set("g")
+	clock.Advance(200 * time.Millisecond)
+	query.Set("go") // Restarts the window
+	clock.Advance(299 * time.Millisecond)
+	if len(emitted) != 0 {
+		t.Fatalf("emitted %v inside the window, want nothing", emitted)
+	}
+
+	clock.Advance(time.Millisecond)
+	if want := []string{"go"}; !slices.Equal(emitted, want) {
+		t.Errorf("emitted %v, want %v", emitted, want)
+	}
+}
+
+// TestFakeClock_EffectDebounce verifies a debounced effect runs when the fake clock passes its quiet period
+func TestFakeClock_EffectDebounce(t *testing.T) {
+	clock := NewFakeClock(time.Now())
+	count := New(0)
+	var seen []int
+	eff := EffectWithOptions(func() func() {
+		seen = append(seen, count.Get())
+		return nil
+	}, EffectOptions{Debounce: time.Second, Clock: clock}, count.AsReadonly())
+	defer eff.Stop()
+
+	count.Set(1)
+	count.Set(2)
+	clock.Advance(time.Second)
+
+	if want := []int{0, 2}; !slices.Equal(seen, want) {
+		t.Errorf("effect saw %v, want %v", seen, want)
+	}
+}
+
+// TestFakeClock_AutoResetAndMaxAge verifies NewAutoReset and MaxAge follow the fake clock
+func TestFakeClock_AutoResetAndMaxAge(t *testing.T) {
+	clock := NewFakeClock(time.Now())
+
+	status := NewAutoReset("", "", 2*time.Second, Options[string]{Clock: clock})
+	defer status.Close()
+	status.Set("Copied!")
+	clock.Advance(time.Second)
+	if got := status.Get(); got != "Copied!" {
+		t.Errorf("Get() = %q before the countdown ends, want Copied!", got)
+	}
+	clock.Advance(time.Second)
+	if got := status.Get(); got != "" {
+		t.Errorf("Get() = %q after the countdown, want reverted", got)
+	}
+	if got := status.Fork().(*autoResetSignal[string]).clock; got != clock {
+		t.Error("Fork() dropped the clock")
+	}
+
+	fetches := 0
+	rate := NewWithOptions(0, Options[int]{
+		MaxAge:  time.Minute,
+		Refresh: func() (int, error) { fetches++; return fetches, nil },
+		Clock:   clock,
+	})
+	rate.Get()
+	clock.Advance(time.Minute + time.Second)
+	if got := rate.Get(); got != 1 || fetches != 1 {
+		t.Errorf("Get() = %d after MaxAge with %d fetches, want 1 and 1", got, fetches)
+	}
+}
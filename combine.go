@@ -0,0 +1,150 @@
+package signals
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"sync"
+)
+
+// CombinedSignal is a derived signal that holds subscriptions to its
+// sources until Stop is called.
+type CombinedSignal[T any] interface {
+	ReadonlySignal[T]
+
+	// Stop unsubscribes from all sources. The signal keeps its last value.
+	// Safe to call multiple times.
+	Stop()
+}
+
+// All derives a computed that is true when every dep is true (and for no
+// deps), e.g., "every field is valid". It updates on any change of the
+// deps but only notifies when the result flips. Call Cleanup when done.
+//
+// Example:
+//
+//	canSubmit := signals.All(nameValid, emailValid, termsAccepted)
+//	defer canSubmit.Cleanup()
+func All(deps ...ReadonlySignal[bool]) ComputedSignal[bool] {
+	return boolCombinator(deps, func(deps []ReadonlySignal[bool]) bool {
+		return !slices.ContainsFunc(deps, func(d ReadonlySignal[bool]) bool { return !d.Get() })
+	})
+}
+
+// Any derives a computed that is true when at least one dep is true (false
+// for no deps), e.g., "some field is dirty". Like All, it only notifies
+// when the result flips. Call Cleanup when done.
+//
+// Example:
+//
+//	unsaved := signals.Any(nameDirty, emailDirty)
+//	defer unsaved.Cleanup()
+func Any(deps ...ReadonlySignal[bool]) ComputedSignal[bool] {
+	return boolCombinator(deps, func(deps []ReadonlySignal[bool]) bool {
+		return slices.ContainsFunc(deps, ReadonlySignal[bool].Get)
+	})
+}
+
+// boolCombinator implements All and Any: a computed applying combine to
+// a copy of deps, notifying only when the result flips.
+func boolCombinator(deps []ReadonlySignal[bool], combine func([]ReadonlySignal[bool]) bool) ComputedSignal[bool] {
+	deps = slices.Clone(deps)
+	anyDeps := make([]any, len(deps))
+	for i, d := range deps {
+		anyDeps[i] = d
+	}
+	return ComputedWithOptions(
+		func() bool { return combine(deps) },
+		Options[bool]{Equal: func(a, b bool) bool { return a == b }},
+		anyDeps...,
+	)
+}
+
+// combinedMap is the internal implementation of CombineMap.
+type combinedMap[K comparable, V any] struct {
+	// sources is the input map captured at construction
+	sources map[K]ReadonlySignal[V]
+
+	// snapshot holds the latest combined map; never exposed without copying
+	snapshot *signal[map[K]V]
+
+	// unsubscribes holds cleanup functions for sources
+	unsubscribes []Unsubscribe
+
+	// stopOnce makes Stop idempotent
+	stopOnce sync.Once
+}
+
+// CombineMap derives a single signal holding the current value of every
+// signal in m, by key. It updates whenever any of the signals changes.
+//
+// The input map is captured at construction: adding or removing keys later
+// has no effect, so build a new combined signal instead (and Stop the old one).
+//
+// Get and subscribers receive a fresh copy of the map, so callers may modify it.
+//
+// Example:
+//
+//	scores := map[string]signals.ReadonlySignal[int]{
+//	    "alice": alice.AsReadonly(),
+//	    "bob":   bob.AsReadonly(),
+//	}
+//	all := signals.CombineMap(scores)
+//	defer all.Stop()
+//
+//	fmt.Println(all.Get()) // map[alice:3 bob:5]
+func CombineMap[K comparable, V any](m map[K]ReadonlySignal[V]) CombinedSignal[map[K]V] {
+	c := &combinedMap[K, V]{sources: maps.Clone(m)}
+	c.snapshot = newSignal(c.collect(), Options[map[K]V]{})
+
+	for _, src := range c.sources {
+		unsub := trackDependentHelper(src, DependentComputed, reactionFunc(c.rebuild))
+		c.unsubscribes = append(c.unsubscribes, unsub)
+	}
+	return c
+}
+
+// collect reads the current value of every source.
+func (c *combinedMap[K, V]) collect() map[K]V {
+	values := make(map[K]V, len(c.sources))
+	for k, src := range c.sources {
+		values[k] = src.Get()
+	}
+	return values
+}
+
+// rebuild replaces the snapshot after a source change.
+// Collecting inside the transform serializes concurrent rebuilds,
+// so the last one to run always sees the latest source values.
+func (c *combinedMap[K, V]) rebuild() {
+	c.snapshot.Update(func(map[K]V) map[K]V { return c.collect() })
+}
+
+// Get returns a copy of the combined map.
+func (c *combinedMap[K, V]) Get() map[K]V {
+	return maps.Clone(c.snapshot.Get())
+}
+
+// Subscribe registers a callback that receives a copy of each combined map.
+func (c *combinedMap[K, V]) Subscribe(ctx context.Context, fn func(map[K]V)) Unsubscribe {
+	return c.snapshot.Subscribe(ctx, func(v map[K]V) { fn(maps.Clone(v)) })
+}
+
+// SubscribeForever registers a callback that never auto-cancels.
+func (c *combinedMap[K, V]) SubscribeForever(fn func(map[K]V)) Unsubscribe {
+	return c.Subscribe(context.Background(), fn)
+}
+
+// subscribeDependent registers a downstream computed or effect as a dependent.
+func (c *combinedMap[K, V]) subscribeDependent(kind DependentKind, r reaction) Unsubscribe {
+	return c.snapshot.subscribeDependent(kind, r)
+}
+
+// Stop unsubscribes from all sources.
+func (c *combinedMap[K, V]) Stop() {
+	c.stopOnce.Do(func() {
+		for _, unsub := range c.unsubscribes {
+			unsub()
+		}
+	})
+}
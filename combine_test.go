@@ -0,0 +1,129 @@
+package signals
+
+import (
+	"maps"
+	"slices"
+	"testing"
+)
+
+// TestCombineMap_UpdatesOnChange verifies the combined map follows every keyed signal
+func TestCombineMap_UpdatesOnChange(t *testing.T) {
+	a, b, c := New(1), New(2), New(3)
+	all := CombineMap(map[string]ReadonlySignal[int]{
+		"a": a.AsReadonly(),
+		"b": b.AsReadonly(),
+		"c": c.AsReadonly(),
+	})
+	defer all.Stop()
+
+	if got, want := all.Get(), map[string]int{"a": 1, "b": 2, "c": 3}; !maps.Equal(got, want) {
+		t.Fatalf("Get() = %v, want %v", got, want)
+	}
+
+	var last map[string]int
+	defer all.SubscribeForever(func(m map[string]int) { last = m })()
+
+	b.Set(20)
+	want := map[string]int{"a": 1, "b": 20, "c": 3}
+	if got := all.Get(); !maps.Equal(got, want) {
+		t.Errorf("Get() after b.Set(20) = %v, want %v", got, want)
+	}
+	if !maps.Equal(last, want) {
+		t.Errorf("subscriber saw %v, want %v", last, want)
+	}
+}
+
+// TestCombineMap_DefensiveCopy verifies callers cannot mutate the combined state
+func TestCombineMap_DefensiveCopy(t *testing.T) {
+	a := New(1)
+	all := CombineMap(map[string]ReadonlySignal[int]{"a": a.AsReadonly()})
+	defer all.Stop()
+
+	got := all.Get()
+	got["a"] = 99
+	got["x"] = 1
+
+	if again := all.Get(); !maps.Equal(again, map[string]int{"a": 1}) {
+		t.Errorf("Get() = %v after mutating a previous result, want map[a:1]", again)
+	}
+}
+
+// TestCombineMap_Stop verifies Stop unsubscribes from all sources
+func TestCombineMap_Stop(t *testing.T) {
+	a, b := New(1), New(2)
+	all := CombineMap(map[string]ReadonlySignal[int]{"a": a.AsReadonly(), "b": b.AsReadonly()})
+
+	all.Stop()
+	all.Stop() // Safe to call twice
+	a.Set(10)
+
+	if got := all.Get()["a"]; got != 1 {
+		t.Errorf("Get()[a] = %d after Stop, want 1", got)
+	}
+	if d := a.Dependents(); d.Total() != 0 {
+		t.Errorf("a.Dependents() = %+v after Stop, want none", d)
+	}
+}
+
+// TestAll_FlipsWhenAllTrue verifies All is true only while every input is, and notifies on flips only
+func TestAll_FlipsWhenAllTrue(t *testing.T) {
+	a, b, c := New(false), New(false), New(false)
+	all := All(a.AsReadonly(), b.AsReadonly(), c.AsReadonly())
+	defer all.Cleanup()
+
+	var flips []bool
+	all.SubscribeForever(func(v bool) { flips = append(flips, v) })
+	all.Get()
+
+	a.Set(true)
+	b.Set(true)
+	if all.Get() {
+		t.Error("All = true with one input false")
+	}
+	c.Set(true)
+	if !all.Get() {
+		t.Error("All = false with every input true")
+	}
+	b.Set(false)
+	if all.Get() {
+		t.Error("All = true after an input turned false")
+	}
+
+	if want := []bool{true, false}; !slices.Equal(flips, want) {
+		t.Errorf("notified %v, want %v", flips, want)
+	}
+}
+
+// TestAny_FlipsWhenOneTrue verifies Any is true while at least one input is
+func TestAny_FlipsWhenOneTrue(t *testing.T) {
+	a, b, c := New(false), New(false), New(false)
+	anyTrue := Any(a.AsReadonly(), b.AsReadonly(), c.AsReadonly())
+	defer anyTrue.Cleanup()
+
+	if anyTrue.Get() {
+		t.Error("Any = true with every input false")
+	}
+	b.Set(true)
+	if !anyTrue.Get() {
+		t.Error("Any = false with one input true")
+	}
+	c.Set(true)
+	b.Set(false)
+	if !anyTrue.Get() {
+		t.Error("Any = false with c still true")
+	}
+	c.Set(false)
+	if anyTrue.Get() {
+		t.Error("Any = true after every input turned false")
+	}
+}
+
+// TestAllAny_NoDeps verifies the empty cases follow AND and OR identities
+func TestAllAny_NoDeps(t *testing.T) {
+	if !All().Get() {
+		t.Error("All() = false, want true")
+	}
+	if Any().Get() {
+		t.Error("Any() = true, want false")
+	}
+}
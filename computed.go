@@ -2,19 +2,46 @@ package signals
 
 import (
 	"context"
-	"log"
+	"errors"
+	"log/slog"
 	"runtime/debug"
 	"sync"
 	"sync/atomic"
+	"time"
 )
 
+// ErrComputedCycle is reported when a computed's compute function re-enters
+// the same computed's Get (directly or through other computeds).
+var ErrComputedCycle = errors.New("signals: cyclic computed dependency")
+
+// SkipRecompute, raised with panic from a compute function, keeps the
+// computed's previous value: Get returns the cached value, subscribers are
+// not notified, and nothing is logged or reported to OnPanic. A compute
+// that skips its first run leaves the zero value.
+//
+// Example:
+//
+//	latest := signals.Computed(func() Report {
+//	    if !ready.Get() {
+//	        panic(signals.SkipRecompute) // Keep showing the last report
+//	    }
+//	    return build(data.Get())
+//	}, ready.AsReadonly(), data.AsReadonly())
+var SkipRecompute = skipRecompute{}
+
+// skipRecompute is the type of SkipRecompute.
+type skipRecompute struct{}
+
+func (skipRecompute) String() string { return "signals: recompute skipped" }
+
 // computed is the internal implementation of a computed signal.
 // It lazily evaluates a computation function and caches the result
 // until dependencies change.
 //
 // Uses atomic.Bool for lock-free dirty flag checks.
 type computed[T any] struct {
-	// compute is the function that derives the value
+	// compute is the function that derives the value (protected by mu,
+	// see SetCompute)
 	compute func() T
 
 	// cached is the memoized result
@@ -28,15 +55,100 @@ type computed[T any] struct {
 	// We don't store dependencies themselves, only their unsubscribe functions
 	unsubscribes []Unsubscribe
 
+	// sources are the dependencies' version counters, in dependency order.
+	// Nil if a dependency has none, which disables skipping stale changes.
+	sources []versionSource
+
+	// seen holds each source's version as of the start of the last recompute
+	seen []atomic.Uint64
+
+	// changes counts updates of cached, see version
+	changes atomic.Uint64
+
+	// lastPanic is the panic of the latest compute, or nil if it succeeded
+	lastPanic atomic.Pointer[PanicError]
+
 	// subscribers for this computed signal
 	subscribers map[uint64]func(T)
 	nextID      uint64
 
+	// callbackPool recycles subscriber snapshots between notifications
+	callbackPool sync.Pool
+
 	// mu protects cached, subscribers, and nextID
 	mu sync.RWMutex
 
+	// reactions are the downstream computeds and effects, keyed like dependents
+	reactions    map[uint64]reaction
+	nextReaction uint64
+
+	// dependents records the kind of each reaction
+	dependents map[uint64]DependentKind
+
+	// reactionsMu protects reactions, dependents, and nextReaction. Waves
+	// read them without mu, which compute holds for its whole run.
+	reactionsMu sync.RWMutex
+
+	// lastWave is the ID of the latest wave that reached this computed
+	lastWave atomic.Uint64
+
+	// force makes the next settle recompute even if no dependency version
+	// changed: before the first compute, and on Recompute
+	force atomic.Bool
+
+	// depth is the computed's height in the dependency graph, see settler
+	depth int
+
+	// equal optionally reports a recomputed value unchanged (Options.Equal)
+	equal EqualFunc[T]
+
 	// onPanic is optional custom panic handler
 	onPanic func(any, []byte)
+
+	// logger is the fallback for panics without onPanic (nil means package log)
+	logger *slog.Logger
+
+	// onTiming optionally receives compute and notify durations
+	onTiming func(op string, d time.Duration)
+
+	// slow times subscribers (nil unless Options.SlowSubscriberThreshold)
+	slow *slowWatch
+
+	// epoch is incremented at the start and end of each recompute
+	// (odd while computing). Used for cycle detection, see cycle.go.
+	epoch atomic.Uint64
+
+	// owner is the ID of the goroutine running compute, if identified.
+	owner atomic.Uint64
+
+	// waiters counts Gets waiting for the recompute in flight to finish
+	waiters atomic.Int32
+
+	// flight is closed when the recompute in flight ends. It is only made
+	// once a Get waits for it (protected by flightMu).
+	flight   chan struct{}
+	flightMu sync.Mutex
+
+	// computeTimeout bounds how long Get waits for compute (zero means no limit)
+	computeTimeout time.Duration
+
+	// fallback is cached when compute exceeds computeTimeout
+	fallback T
+
+	// late is set while a compute that exceeded computeTimeout is still
+	// running, and rerun once a change arrived meanwhile (protected by mu)
+	late  bool
+	rerun bool
+
+	// coalesceWindow delays waves reaching the computed, see Options.CoalesceWindow
+	coalesceWindow time.Duration
+	clock          Clock
+
+	// coalescing is set while a delayed wave is scheduled
+	coalescing atomic.Bool
+
+	// evaluated is set once compute has returned, see HasComputed
+	evaluated atomic.Bool
 }
 
 // Computed creates a read-only signal that derives its value from a computation function.
@@ -46,7 +158,8 @@ type computed[T any] struct {
 //
 // Dependencies must be explicitly passed as additional arguments. Each dependency can be
 // a ReadonlySignal of any type. When any dependency changes, this computed signal is
-// marked dirty and will recompute on the next Get().
+// marked dirty and will recompute on the next Get(). A nil dependency is skipped and
+// reported as ErrNilDependency (to OnPanic, or logged).
 //
 // The computed signal uses lazy evaluation and memoization:
 //   - Only computes when accessed (Get)
@@ -84,10 +197,48 @@ type computed[T any] struct {
 //	    count.AsReadonly(),  // ReadonlySignal[int]
 //	    name.AsReadonly(),   // ReadonlySignal[string]
 //	)
-func Computed[T any](compute func() T, deps ...any) ReadonlySignal[T] {
+func Computed[T any](compute func() T, deps ...any) ComputedSignal[T] {
 	return ComputedWithOptions(compute, Options[T]{}, deps...)
 }
 
+// Derive creates a computed signal of type R from dependencies of any mix
+// of types. It is Computed under a name that reads better for fan-in:
+//
+//	status := signals.Derive(func() string {
+//	    return fmt.Sprintf("%s: %d since %s", user.Get().Name, count.Get(), since.Get())
+//	}, user, count, since) // ReadonlySignal[User], [int], [time.Time]
+//
+// Each dependency can be any ReadonlySignal[X]. Signals from this package
+// are tracked directly; other implementations are subscribed to through
+// their SubscribeForever, whatever X is.
+func Derive[R any](fn func() R, deps ...any) ComputedSignal[R] {
+	return Computed(fn, deps...)
+}
+
+// Field derives a computed holding one part of s, typically a field of a
+// big struct, that only notifies when that part changes, not on every
+// change of s. Call Cleanup when done.
+//
+// Parts are compared with the Equal of the optional Options (only the first
+// is used), else with == for comparable types, else by identity (see
+// IdentityEqual): a slice field notifies when it is replaced, not when an
+// equal copy is stored.
+//
+// Example:
+//
+//	port := signals.Field(config.AsReadonly(), func(c Config) int { return c.Port })
+//	defer port.Cleanup()
+//	port.SubscribeForever(restartListener) // Not called when other fields change
+func Field[T, F any](s ReadonlySignal[T], get func(T) F, opts ...Options[F]) ComputedSignal[F] {
+	o := firstOptions(opts)
+	if o.Equal == nil {
+		if o.Equal = comparableEqual[F](); o.Equal == nil {
+			o.Equal = IdentityEqual[F]()
+		}
+	}
+	return ComputedWithOptions(func() F { return get(s.Get()) }, o, s)
+}
+
 // ComputedWithOptions creates a computed signal with custom options.
 //
 // Use this when you need custom panic handling for the compute function or subscribers.
@@ -104,21 +255,43 @@ func Computed[T any](compute func() T, deps ...any) ReadonlySignal[T] {
 //	    },
 //	    count.AsReadonly(),
 //	)
-func ComputedWithOptions[T any](compute func() T, opts Options[T], deps ...any) ReadonlySignal[T] {
+func ComputedWithOptions[T any](compute func() T, opts Options[T], deps ...any) ComputedSignal[T] {
 	c := &computed[T]{
 		compute:     compute,
 		subscribers: make(map[uint64]func(T)),
+		reactions:   make(map[uint64]reaction),
+		dependents:  make(map[uint64]DependentKind),
 		onPanic:     opts.OnPanic,
+		logger:      opts.Logger,
+		onTiming:    opts.OnTiming,
+		equal:       opts.Equal,
+
+		computeTimeout: opts.ComputeTimeout,
+		fallback:       opts.FallbackValue,
+		coalesceWindow: opts.CoalesceWindow,
+		clock:          clockOrReal(opts.Clock),
+		slow:           newSlowWatch(opts),
 	}
 
 	// Mark as dirty initially (needs first computation)
 	c.dirty.Store(true)
+	c.force.Store(true)
+
+	deps = nonNilDependencies(deps, c.reportError)
+	c.depth = dependencyHeight(deps)
+
+	// Versions must be in place before the first change notification
+	c.sources = versionSources(deps)
+	c.seen = make([]atomic.Uint64, len(c.sources))
 
 	// Track dependencies using type erasure
 	for _, dep := range deps {
 		c.trackDependency(dep)
 	}
 
+	if opts.WeakDependencies {
+		return collectable(c)
+	}
 	return c
 }
 
@@ -127,7 +300,7 @@ func ComputedWithOptions[T any](compute func() T, opts Options[T], deps ...any)
 //
 // This is an internal method used by Computed() and ComputedWithOptions().
 func (c *computed[T]) trackDependency(dep any) {
-	unsub := trackDependencyHelper(dep, c.markDirty)
+	unsub := trackDependentHelper(dep, DependentComputed, c)
 	c.unsubscribes = append(c.unsubscribes, unsub)
 }
 
@@ -146,8 +319,18 @@ func (c *computed[T]) Get() T {
 		return cached
 	}
 
-	// Slow path: recompute with lock
-	c.mu.Lock()
+	// Slow path: share a recompute already in flight instead of queueing
+	// on mu, so that its result reaches all waiting readers at once
+	if c.awaitRecompute() && !c.dirty.Load() {
+		c.mu.RLock()
+		cached := c.cached
+		c.mu.RUnlock()
+		return cached
+	}
+
+	if !c.lock() {
+		return c.cached
+	}
 	defer c.mu.Unlock()
 
 	// Double-check locking: another goroutine might have recomputed
@@ -155,23 +338,266 @@ func (c *computed[T]) Get() T {
 		return c.cached
 	}
 
-	// Recompute with panic recovery
-	func() {
-		defer func() {
-			if r := recover(); r != nil {
-				if c.onPanic != nil {
-					c.onPanic(r, debug.Stack())
-				} else {
-					log.Printf("signals: panic in computed function: %v\n%s", r, debug.Stack())
-				}
-				// Don't update cached value on panic - keep old value
+	c.recompute()
+	return c.cached
+}
+
+// awaitRecompute waits for the recompute in progress on another goroutine,
+// if any, and reports whether it did. A recompute running on the caller
+// is a cycle (see lock), which is never waited for.
+func (c *computed[T]) awaitRecompute() bool {
+	if c.epoch.Load()%2 == 0 || c.recomputingOnCaller() {
+		return false
+	}
+
+	c.waiters.Add(1)
+	defer c.waiters.Add(-1)
+
+	c.flightMu.Lock()
+	if c.epoch.Load()%2 == 0 {
+		c.flightMu.Unlock()
+		return true // Finished meanwhile
+	}
+	if c.flight == nil {
+		c.flight = make(chan struct{})
+	}
+	flight := c.flight
+	c.flightMu.Unlock()
+
+	<-flight
+	return true
+}
+
+// lock acquires mu to recompute. It reports false without locking on a
+// cycle, i.e. when compute is re-entering this computed on the same
+// goroutine: this goroutine already holds mu, so the caller must use the
+// stale cache instead of deadlocking.
+func (c *computed[T]) lock() bool {
+	if !c.mu.TryLock() {
+		if c.recomputingOnCaller() {
+			c.reportError(ErrComputedCycle)
+			return false
+		}
+		c.mu.Lock()
+	}
+	return true
+}
+
+// IsDirty reports whether the cached value is stale, i.e. whether the next
+// Get will recompute. It reads the dirty flag only and has no side effects.
+//
+// A computed is dirty before its first Get and while a recompute is in
+// progress. Dependency changes recompute eagerly to notify subscribers,
+// computeds, and effects, so the flag is cleared again once the triggering
+// Set returns. A computed nobody observes stays dirty until the next Get.
+func (c *computed[T]) IsDirty() bool {
+	return c.dirty.Load()
+}
+
+// TryGet is like Get, but also reports whether the value is fresh: if the
+// latest compute panicked, it returns the stale cached value together with
+// a *PanicError describing the panic. After a successful compute the error
+// is nil.
+func (c *computed[T]) TryGet() (T, error) {
+	value := c.Get()
+	if pe := c.lastPanic.Load(); pe != nil {
+		return value, pe
+	}
+	return value, nil
+}
+
+// IsDegraded reports whether the latest compute panicked, leaving the
+// stale cached value in place (TryGet returns the panic). It doesn't
+// recompute, and is false again after the next successful compute.
+func (c *computed[T]) IsDegraded() bool {
+	return c.lastPanic.Load() != nil
+}
+
+// SetCompute replaces the compute function, keeping the subscribers,
+// dependencies, and dependents. The computed recomputes with fn right
+// away and notifies like after a dependency change, unless nobody
+// observes it (then the next Get recomputes). Only the dependencies the
+// computed was created with trigger recomputes of fn.
+func (c *computed[T]) SetCompute(fn func() T) {
+	if !c.lock() {
+		return
+	}
+	c.compute = fn
+	c.dirty.Store(true)
+	c.force.Store(true) // Dependencies haven't changed, the function has
+	c.mu.Unlock()
+
+	c.fire()
+}
+
+// HasComputed reports whether compute has returned at least once, without
+// computing. It is false before the first Get, and stays false while
+// compute panics (the cached value is then still the zero value).
+func (c *computed[T]) HasComputed() bool {
+	return c.evaluated.Load()
+}
+
+// recompute runs compute with panic recovery, stores the result, and
+// clears dirty. On panic the old cached value is kept. Reports true if
+// there is nothing to notify: compute raised SkipRecompute, or Equal
+// matched the cached value. Caller must hold mu.
+func (c *computed[T]) recompute() (kept bool) {
+	c.beginCompute()
+	defer c.endCompute()
+	defer c.dirty.Store(false) // Before endCompute wakes waiters
+
+	// Record versions before compute reads the dependencies: a write that
+	// lands while computing then still counts as unseen
+	c.force.Store(false)
+	c.markSeen()
+
+	if c.computeTimeout > 0 {
+		return c.recomputeWithTimeout()
+	}
+	v, ok, skipped := c.evaluate(c.compute)
+	if ok {
+		return !c.store(v)
+	}
+	return skipped
+}
+
+// markSeen records the dependencies' current versions as seen by the
+// compute about to run. Caller must hold mu.
+func (c *computed[T]) markSeen() {
+	for i, src := range c.sources {
+		c.seen[i].Store(src.version())
+	}
+}
+
+// store caches a computed value, unless Equal reports it unchanged.
+// Reports whether it did. Caller must hold mu.
+func (c *computed[T]) store(v T) bool {
+	if c.equal != nil && c.changes.Load() > 0 && c.equal(c.cached, v) {
+		return false
+	}
+	c.cached = v
+	c.changes.Add(1)
+	return true
+}
+
+// evaluate calls compute, reporting false if it panicked, and skipped if
+// it raised SkipRecompute. The outcome is recorded for TryGet.
+func (c *computed[T]) evaluate(compute func() T) (value T, ok, skipped bool) {
+	if c.onTiming != nil {
+		defer reportTiming(c.onTiming, TimingCompute, time.Now())
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			if r == SkipRecompute {
+				skipped = true
+				return
 			}
-		}()
-		c.cached = c.compute()
+			stack := debug.Stack()
+			c.lastPanic.Store(&PanicError{Value: r, Stack: stack})
+			if c.onPanic != nil {
+				c.onPanic(r, stack)
+			} else {
+				logPanic(c.logger, "computed function", r, stack)
+			}
+			// Don't update cached value on panic - keep old value
+		}
 	}()
+	if purityCheck.Load() {
+		defer leaveCompute(enterCompute())
+	}
+	value = compute()
+	if !c.evaluated.Load() {
+		c.evaluated.Store(true)
+	}
+	if c.lastPanic.Load() != nil {
+		c.lastPanic.Store(nil)
+	}
+	return value, true, false
+}
 
-	c.dirty.Store(false)
-	return c.cached
+// computeResult is the outcome of a compute run on its own goroutine.
+type computeResult[T any] struct {
+	value   T
+	ok      bool
+	skipped bool
+}
+
+// recomputeWithTimeout runs compute on its own goroutine and waits up to
+// computeTimeout for it. If compute is too slow, the fallback is cached and
+// the result is published whenever it arrives. Until then no other compute
+// starts: changes meanwhile make it rerun once, see finishLate. Caller must
+// hold mu.
+func (c *computed[T]) recomputeWithTimeout() (kept bool) {
+	if c.late {
+		c.rerun = true
+		return true
+	}
+
+	done := make(chan computeResult[T])
+	abandoned := make(chan struct{})
+	compute := c.compute // Read under mu, which may be released before it runs
+	go func() {
+		v, ok, skipped := c.evaluate(compute)
+		select {
+		case done <- computeResult[T]{value: v, ok: ok, skipped: skipped}:
+		case <-abandoned:
+			c.finishLate(v, ok)
+		}
+	}()
+
+	expired := make(chan struct{})
+	timer := c.clock.AfterFunc(c.computeTimeout, func() { close(expired) })
+	defer timer.Stop()
+
+	select {
+	case res := <-done:
+		if !res.ok {
+			return res.skipped
+		}
+		return !c.store(res.value)
+	case <-expired:
+		c.late = true
+		settling.add(1) // Until the late result is published
+		close(abandoned)
+		c.cached = c.fallback
+		c.changes.Add(1)
+		return false
+	}
+}
+
+// finishLate publishes the result of a compute that exceeded
+// computeTimeout, after rerunning compute if a change arrived meanwhile.
+// The result is dropped if a dependency changed since without a recompute:
+// the next Get recomputes.
+func (c *computed[T]) finishLate(value T, ok bool) {
+	defer settling.done(1)
+	c.mu.Lock()
+	for c.rerun {
+		c.rerun = false
+		c.markSeen()
+		compute := c.compute
+		c.mu.Unlock()
+		value, ok, _ = c.evaluate(compute)
+		c.mu.Lock()
+	}
+	c.late = false
+	if !ok || c.dirty.Load() || !c.store(value) {
+		c.mu.Unlock()
+		return // Failed, stale, or the same as the fallback
+	}
+	c.mu.Unlock()
+
+	c.notifySubscribers(value)
+	propagate(c.snapshotReactions(), c.reactionPanic, nil)
+}
+
+// reportError delivers a non-panic failure to onPanic or the log.
+func (c *computed[T]) reportError(err error) {
+	if c.onPanic != nil {
+		c.onPanic(err, debug.Stack())
+	} else {
+		logError(c.logger, err)
+	}
 }
 
 // Subscribe registers a callback to be notified when the computed value changes.
@@ -186,37 +612,38 @@ func (c *computed[T]) Subscribe(ctx context.Context, fn func(T)) Unsubscribe {
 	c.mu.Lock()
 	id := c.nextID
 	c.nextID++
-	c.subscribers[id] = fn
+	c.subscribers[id] = watchSlow(c.slow, fn)
 	c.mu.Unlock()
 
-	// Channel for cleanup coordination
-	done := make(chan struct{})
-
-	// Auto-cleanup on context cancellation
-	go func() {
-		select {
-		case <-ctx.Done():
-			c.mu.Lock()
-			delete(c.subscribers, id)
-			c.mu.Unlock()
-			close(done)
-		case <-done:
-			// Manual unsubscribe
-		}
-	}()
-
-	// Return manual unsubscribe
-	return func() {
+	unsubscribe := func() {
 		c.mu.Lock()
 		delete(c.subscribers, id)
 		c.mu.Unlock()
+	}
 
-		select {
-		case <-done:
-			// Already closed
-		default:
-			close(done)
-		}
+	// Never-canceled contexts (SubscribeForever) need no watcher
+	if ctx.Done() == nil {
+		return trackLeak(unsubscribe, c.subscribed(id))
+	}
+
+	// Auto-cleanup on context cancellation, without a parked goroutine
+	stop := context.AfterFunc(ctx, unsubscribe)
+
+	// Return manual unsubscribe
+	return trackLeak(func() {
+		unsubscribe()
+		stop()
+	}, c.subscribed(id))
+}
+
+// subscribed returns a func reporting whether subscriber id is still
+// registered, for trackLeak.
+func (c *computed[T]) subscribed(id uint64) func() bool {
+	return func() bool {
+		c.mu.RLock()
+		defer c.mu.RUnlock()
+		_, ok := c.subscribers[id]
+		return ok
 	}
 }
 
@@ -225,41 +652,235 @@ func (c *computed[T]) SubscribeForever(fn func(T)) Unsubscribe {
 	return c.Subscribe(context.Background(), fn)
 }
 
-// markDirty marks the computed value as stale and triggers recomputation.
-//
-// This is called when any dependency changes.
-// Always triggers recomputation and notification to ensure subscribers are notified.
-func (c *computed[T]) markDirty() {
-	// Mark as dirty
+// Dependents reports how many computeds and effects depend on this computed.
+func (c *computed[T]) Dependents() Dependents {
+	c.reactionsMu.RLock()
+	defer c.reactionsMu.RUnlock()
+	return countDependents(c.dependents)
+}
+
+// subscribeDependent registers a downstream computed or effect as a dependent.
+func (c *computed[T]) subscribeDependent(kind DependentKind, r reaction) Unsubscribe {
+	c.reactionsMu.Lock()
+	id := c.nextReaction
+	c.nextReaction++
+	c.reactions[id] = r
+	c.dependents[id] = kind
+	c.reactionsMu.Unlock()
+
+	return func() {
+		c.reactionsMu.Lock()
+		delete(c.reactions, id)
+		delete(c.dependents, id)
+		c.reactionsMu.Unlock()
+	}
+}
+
+// snapshotReactions copies the downstream computeds and effects.
+func (c *computed[T]) snapshotReactions() []reaction {
+	c.reactionsMu.RLock()
+	defer c.reactionsMu.RUnlock()
+	if len(c.reactions) == 0 {
+		return nil
+	}
+	reactions := make([]reaction, 0, len(c.reactions))
+	for _, r := range c.reactions {
+		reactions = append(reactions, r)
+	}
+	return reactions
+}
+
+// stale marks the computed dirty when a dependency changes, and passes the
+// wave on to everything downstream (see wave.go).
+func (c *computed[T]) stale(w *wave) {
+	if !w.claim(&c.lastWave) {
+		return
+	}
 	c.dirty.Store(true)
+	if c.coalesceWindow > 0 {
+		c.scheduleWave()
+		return
+	}
+	c.join(w)
+}
+
+// join adds the dirty computed to w, along with everything downstream.
+func (c *computed[T]) join(w *wave) {
+	w.computeds = append(w.computeds, c)
 
-	// Always recompute and notify
-	// This ensures that even if the signal was already dirty (e.g., initial state),
-	// subscribers still get notified when dependencies change.
-	newValue := c.Get()
+	c.reactionsMu.RLock()
+	defer c.reactionsMu.RUnlock()
+	for _, r := range c.reactions {
+		r.stale(w)
+	}
+}
+
+// scheduleWave starts a wave at the computed once its coalesce window
+// has passed, unless one is already scheduled. Until then the computed
+// only stays dirty, so Get is fresh, but nothing is notified.
+func (c *computed[T]) scheduleWave() {
+	if !c.coalescing.CompareAndSwap(false, true) {
+		return
+	}
+	settling.add(1)
+	c.clock.AfterFunc(c.coalesceWindow, func() {
+		defer settling.done(1)
+		c.coalescing.Store(false)
+		c.force.Store(true) // A Get may have recomputed already
+		propagate([]reaction{coalescedWave[T]{c}}, c.reactionPanic, nil)
+	})
+}
+
+// coalescedWave is the reaction starting a computed's delayed wave: it
+// joins the wave directly, without waiting for another window.
+type coalescedWave[T any] struct {
+	c *computed[T]
+}
+
+func (r coalescedWave[T]) stale(w *wave) {
+	if w.claim(&r.c.lastWave) {
+		r.c.dirty.Store(true)
+		r.c.join(w)
+	}
+}
+
+func (coalescedWave[T]) settles() bool { return true }
+func (r coalescedWave[T]) fire()       { r.c.fire() }
+
+// settles reports true: computeds are ordered by waves.
+func (c *computed[T]) settles() bool {
+	return true
+}
+
+// fire starts a wave at the computed, as if a dependency had changed.
+func (c *computed[T]) fire() {
+	propagate([]reaction{c}, c.reactionPanic, nil)
+}
 
-	// Notify subscribers
-	c.notifySubscribers(newValue)
+// height returns the computed's height, see settler.
+func (c *computed[T]) height() int {
+	return c.depth
+}
+
+// settle recomputes the value marked stale by a wave and notifies
+// subscribers. Every dependency has settled by now.
+//
+// If every dependency's version was already seen by a recompute, the
+// change is already reflected in the cache (e.g., several dependencies
+// changed before their notifications arrived), and the redundant recompute
+// and notification are skipped.
+func (c *computed[T]) settle() {
+	if !c.lock() {
+		return
+	}
+	if c.dirty.Load() {
+		if !c.force.Load() && !c.dependenciesChanged() {
+			c.dirty.Store(false)
+			c.mu.Unlock()
+			return
+		}
+		if c.unobserved() {
+			c.mu.Unlock()
+			return // Nobody to notify: stay dirty, the next Get recomputes
+		}
+		if c.recompute() {
+			c.mu.Unlock()
+			return // Kept the cached value, nothing to notify
+		}
+	}
+	value := c.cached
+	c.mu.Unlock()
+
+	c.notifySubscribers(value)
+}
+
+// unobserved reports whether the computed has no subscribers, computeds,
+// or effects. Caller must hold mu.
+func (c *computed[T]) unobserved() bool {
+	if len(c.subscribers) > 0 {
+		return false
+	}
+	c.reactionsMu.RLock()
+	defer c.reactionsMu.RUnlock()
+	return len(c.reactions) == 0
+}
+
+// dependenciesChanged reports whether a dependency has changed since the
+// last recompute started. Always true if versions are not tracked.
+func (c *computed[T]) dependenciesChanged() bool {
+	if c.sources == nil {
+		return true
+	}
+	for i, src := range c.sources {
+		if src.version() != c.seen[i].Load() {
+			return true
+		}
+	}
+	return false
+}
+
+// version returns the number of times the cached value has been updated.
+func (c *computed[T]) version() uint64 {
+	return c.changes.Load()
+}
+
+// reactionPanic reports a panic of a downstream reaction.
+func (c *computed[T]) reactionPanic(r any, _ *[]error) {
+	if c.onPanic != nil {
+		c.onPanic(r, debug.Stack())
+	} else {
+		logPanic(c.logger, "computed subscriber", r, debug.Stack())
+	}
+}
+
+// Recompute forces the computed to re-evaluate and notify subscribers,
+// as if one of its dependencies had changed.
+//
+// This is an escape hatch for derivations that read state outside the
+// signal system (e.g., time.Now or a plain variable). Such computes break
+// the purity assumption, so the package cannot know when they go stale;
+// call Recompute whenever that external state changes.
+//
+// Note: This is not part of the ReadonlySignal interface, but provided as
+// a utility method on the concrete type.
+func (c *computed[T]) Recompute() {
+	c.force.Store(true)
+	c.fire()
 }
 
 // notifySubscribers calls all subscriber callbacks with panic recovery.
 func (c *computed[T]) notifySubscribers(value T) {
+	if c.onTiming != nil {
+		defer reportTiming(c.onTiming, TimingNotify, time.Now())
+	}
+
 	c.mu.RLock()
-	callbacks := make([]func(T), 0, len(c.subscribers))
+	if len(c.subscribers) == 0 {
+		c.mu.RUnlock()
+		return
+	}
+	callbacks, ok := c.callbackPool.Get().(*[]func(T))
+	if !ok {
+		buf := make([]func(T), 0, len(c.subscribers))
+		callbacks = &buf
+	}
 	for _, fn := range c.subscribers {
-		callbacks = append(callbacks, fn)
+		*callbacks = append(*callbacks, fn)
 	}
 	c.mu.RUnlock()
+	defer c.releaseCallbacks(callbacks)
 
-	// Notify outside lock with panic recovery
-	for _, fn := range callbacks {
+	// Notify outside lock with panic recovery. Neither mu nor reactionsMu
+	// is held here (settle and publishLate release mu first), so callbacks
+	// may read this computed or write its dependencies.
+	for _, fn := range *callbacks {
 		func() {
 			defer func() {
 				if r := recover(); r != nil {
 					if c.onPanic != nil {
 						c.onPanic(r, debug.Stack())
 					} else {
-						log.Printf("signals: panic in computed subscriber: %v\n%s", r, debug.Stack())
+						logPanic(c.logger, "computed subscriber", r, debug.Stack())
 					}
 				}
 			}()
@@ -268,11 +889,15 @@ func (c *computed[T]) notifySubscribers(value T) {
 	}
 }
 
+// releaseCallbacks returns a snapshot taken by notifySubscribers to the pool.
+func (c *computed[T]) releaseCallbacks(callbacks *[]func(T)) {
+	clear(*callbacks) // Don't keep unsubscribed callbacks alive
+	*callbacks = (*callbacks)[:0]
+	c.callbackPool.Put(callbacks)
+}
+
 // Cleanup stops all dependency subscriptions.
 // Call this to prevent memory leaks when the computed signal is no longer needed.
-//
-// Note: This is not part of the ReadonlySignal interface, but provided as
-// a utility method on the concrete type.
 func (c *computed[T]) Cleanup() {
 	for _, unsub := range c.unsubscribes {
 		unsub()
@@ -1,6 +1,9 @@
 package signals
 
-import "testing"
+import (
+	"sync"
+	"testing"
+)
 
 // BenchmarkComputed_Get_Clean measures performance of cached reads
 func BenchmarkComputed_Get_Clean(b *testing.B) {
@@ -110,6 +113,67 @@ func BenchmarkComputed_ParallelGet_Clean(b *testing.B) {
 	})
 }
 
+// BenchmarkComputed_ParallelGet_Dirty measures how long it takes for
+// many concurrent readers of an invalidated, slow computed to all get the
+// new value. Coalesced is Get, where the readers share the recompute in
+// flight; Mutex is the plain double-checked lock, where they queue on the
+// mutex and are let through one at a time.
+func BenchmarkComputed_ParallelGet_Dirty(b *testing.B) {
+	const readers = 64
+
+	mutexGet := func(c *computed[int]) int {
+		if !c.dirty.Load() {
+			c.mu.RLock()
+			defer c.mu.RUnlock()
+			return c.cached
+		}
+		if !c.lock() {
+			return c.cached
+		}
+		defer c.mu.Unlock()
+		if c.dirty.Load() {
+			c.recompute()
+		}
+		return c.cached
+	}
+
+	for _, bm := range []struct {
+		name string
+		get  func(*computed[int]) int
+	}{
+		{"Coalesced", (*computed[int]).Get},
+		{"Mutex", mutexGet},
+	} {
+		b.Run(bm.name, func(b *testing.B) {
+			count := New(100_000)
+			comp := Computed(func() int {
+				result := 0
+				for i := range count.Get() {
+					result += i
+				}
+				return result
+			}, count.AsReadonly()).(*computed[int])
+
+			for b.Loop() {
+				comp.dirty.Store(true) // Invalidate without recomputing
+
+				var wg sync.WaitGroup
+				start := make(chan struct{})
+				for range readers {
+					wg.Add(1)
+					go func() {
+						defer wg.Done()
+						<-start
+						_ = bm.get(comp)
+					}()
+				}
+				close(start)
+				wg.Wait()
+			}
+		})
+	}
+}
+
 // BenchmarkComputed_ComplexComputation measures expensive computation
 func BenchmarkComputed_ComplexComputation(b *testing.B) {
 	count := New(100)
@@ -132,3 +196,38 @@ func BenchmarkComputed_ComplexComputation(b *testing.B) {
 		_ = comp.Get() // Should be cached!
 	}
 }
+
+// BenchmarkComputed_Notify measures notifying a computed's subscribers on each recompute
+func BenchmarkComputed_Notify(b *testing.B) {
+	count := New(0)
+	comp := Computed(
+		func() int { return count.Get() * 2 },
+		count.AsReadonly(),
+	)
+	for range 8 {
+		comp.SubscribeForever(func(int) {})
+	}
+	_ = comp.Get()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		count.Set(i) // Recomputes and notifies
+	}
+}
+
+// BenchmarkComputed_Unobserved measures writes to the dependency of a computed nobody observes
+func BenchmarkComputed_Unobserved(b *testing.B) {
+	count := New(0)
+	comp := Computed(
+		func() int { return count.Get() * 2 },
+		count.AsReadonly(),
+	)
+	_ = comp.Get()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		count.Set(i)
+	}
+}
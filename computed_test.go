@@ -2,6 +2,10 @@ package signals
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -407,7 +411,7 @@ func TestComputed_Cleanup(t *testing.T) {
 	time.Sleep(10 * time.Millisecond)
 
 	// Get should return stale cached value (no recompute since dirty was not set)
-	// The value stays at 0 because Cleanup removed the subscription so markDirty was never called
+	// The value stays at 0 because Cleanup removed the subscription so the computed was never notified
 	if got := atomic.LoadInt32(&computeCount); got != 1 {
 		t.Errorf("After Cleanup + Set, computeCount = %d, want 1 (no recompute)", got)
 	}
@@ -515,3 +519,1021 @@ func TestComputed_RapidDependencyChanges(t *testing.T) {
 		t.Errorf("Final result = %d, want 198", result)
 	}
 }
+
+// TestComputed_Cycle verifies a cycle between computeds returns instead of deadlocking
+func TestComputed_Cycle(t *testing.T) {
+	src := New(1)
+	var cycles int32
+
+	var a, b ReadonlySignal[int]
+	a = ComputedWithOptions(
+		func() int { return b.Get() + src.Get() },
+		Options[int]{
+			OnPanic: func(err any, _ []byte) {
+				if e, ok := err.(error); ok && errors.Is(e, ErrComputedCycle) {
+					atomic.AddInt32(&cycles, 1)
+				}
+			},
+		},
+		src.AsReadonly(),
+	)
+	b = Computed(func() int { return a.Get() * 2 })
+
+	done := make(chan int)
+	go func() { done <- a.Get() }()
+
+	select {
+	case got := <-done:
+		// b saw a's stale cache (0), so a = 0*2 + 1
+		if got != 1 {
+			t.Errorf("a.Get() = %d, want 1", got)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Cyclic computed Get deadlocked")
+	}
+
+	if got := atomic.LoadInt32(&cycles); got != 1 {
+		t.Errorf("Cycle reported %d times, want 1", got)
+	}
+}
+
+// TestComputed_Recompute verifies Recompute picks up state outside the signal system
+func TestComputed_Recompute(t *testing.T) {
+	var external atomic.Int32
+	external.Store(1)
+
+	comp := Computed(func() int32 { return external.Load() * 10 })
+
+	var notified []int32
+	unsub := comp.SubscribeForever(func(v int32) {
+		notified = append(notified, v)
+	})
+	defer unsub()
+
+	if got := comp.Get(); got != 10 {
+		t.Fatalf("Initial Get() = %d, want 10", got)
+	}
+
+	external.Store(2)
+	if got := comp.Get(); got != 10 {
+		t.Errorf("Before Recompute, Get() = %d, want stale 10", got)
+	}
+
+	comp.(*computed[int32]).Recompute()
+
+	if got := comp.Get(); got != 20 {
+		t.Errorf("After Recompute, Get() = %d, want 20", got)
+	}
+	if len(notified) != 1 || notified[0] != 20 {
+		t.Errorf("Subscribers notified with %v, want [20]", notified)
+	}
+}
+
+// TestComputed_ConcurrentGetNotCycle verifies goroutines waiting on another
+// goroutine's recompute are not reported as cycles
+func TestComputed_ConcurrentGetNotCycle(t *testing.T) {
+	src := New(1)
+	var cycles int32
+
+	slow := ComputedWithOptions(
+		func() int {
+			time.Sleep(5 * time.Millisecond)
+			return src.Get() * 2
+		},
+		Options[int]{
+			OnPanic: func(any, []byte) { atomic.AddInt32(&cycles, 1) },
+		},
+		src.AsReadonly(),
+	)
+	// Another computed recomputing at the same time forces identified owners
+	other := Computed(func() int {
+		time.Sleep(5 * time.Millisecond)
+		return slow.Get() + 1
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func() { defer wg.Done(); slow.Get() }()
+		go func() { defer wg.Done(); other.Get() }()
+	}
+	wg.Wait()
+
+	if got := atomic.LoadInt32(&cycles); got != 0 {
+		t.Errorf("Cycle reported %d times for concurrent reads, want 0", got)
+	}
+	if got := other.Get(); got != 3 {
+		t.Errorf("other.Get() = %d, want 3", got)
+	}
+}
+
+// TestComputed_IsDirty verifies IsDirty tracks staleness without forcing a recompute
+func TestComputed_IsDirty(t *testing.T) {
+	count := New(1)
+	var computes int32
+	var dirtyDuringCompute atomic.Bool
+
+	var dc DirtyChecker
+	comp := Computed(func() int {
+		atomic.AddInt32(&computes, 1)
+		if dc != nil {
+			dirtyDuringCompute.Store(dc.IsDirty())
+		}
+		return count.Get() * 2
+	}, count.AsReadonly())
+
+	dc, ok := comp.(DirtyChecker)
+	if !ok {
+		t.Fatal("computed does not implement DirtyChecker")
+	}
+
+	if !dc.IsDirty() {
+		t.Error("IsDirty() = false before first Get, want true")
+	}
+	if got := atomic.LoadInt32(&computes); got != 0 {
+		t.Errorf("IsDirty triggered %d computes, want 0", got)
+	}
+
+	comp.Get()
+	if dc.IsDirty() {
+		t.Error("IsDirty() = true after Get, want false")
+	}
+
+	// A dependency change marks the computed dirty, then recomputes it
+	// eagerly to notify subscribers.
+	comp.SubscribeForever(func(int) {})
+	count.Set(2)
+	if !dirtyDuringCompute.Load() {
+		t.Error("IsDirty() = false during recompute after dependency change, want true")
+	}
+	if dc.IsDirty() {
+		t.Error("IsDirty() = true after recompute, want false")
+	}
+	if got := atomic.LoadInt32(&computes); got != 2 {
+		t.Errorf("computes = %d, want 2", got)
+	}
+}
+
+// TestComputed_UnobservedStaysDirty verifies a computed without subscribers
+// or dependents is not recomputed until read
+func TestComputed_UnobservedStaysDirty(t *testing.T) {
+	count := New(1)
+	var computes int32
+	comp := Computed(func() int {
+		atomic.AddInt32(&computes, 1)
+		return count.Get() * 2
+	}, count.AsReadonly())
+	comp.Get()
+
+	count.Set(2)
+	count.Set(3)
+	if got := atomic.LoadInt32(&computes); got != 1 {
+		t.Errorf("computes = %d after unobserved Sets, want 1", got)
+	}
+	if !comp.(DirtyChecker).IsDirty() {
+		t.Error("IsDirty() = false after unobserved Set, want true")
+	}
+	if got := comp.Get(); got != 6 {
+		t.Errorf("Get() = %d, want 6", got)
+	}
+	if got := atomic.LoadInt32(&computes); got != 2 {
+		t.Errorf("computes = %d after Get, want 2", got)
+	}
+}
+
+// TestComputed_ComputeTimeoutFallback verifies Get returns the fallback for a slow compute
+// and the real result lands later
+func TestComputed_ComputeTimeoutFallback(t *testing.T) {
+	src := New(2)
+	comp := ComputedWithOptions(func() int {
+		v := src.Get()
+		time.Sleep(100 * time.Millisecond)
+		return v * 10
+	}, Options[int]{
+		ComputeTimeout: 10 * time.Millisecond,
+		FallbackValue:  -1,
+	}, src.AsReadonly())
+
+	var notified atomic.Int32
+	comp.SubscribeForever(func(v int) { notified.Store(int32(v)) })
+
+	start := time.Now()
+	if got := comp.Get(); got != -1 {
+		t.Errorf("Get() = %d, want fallback -1", got)
+	}
+	if elapsed := time.Since(start); elapsed >= 100*time.Millisecond {
+		t.Errorf("Get() took %v, want it to return at the deadline", elapsed)
+	}
+
+	waitFor(t, func() bool { return comp.Get() == 20 })
+	waitFor(t, func() bool { return notified.Load() == 20 })
+}
+
+// TestComputed_ComputeTimeoutFast verifies computes that finish in time behave normally
+func TestComputed_ComputeTimeoutFast(t *testing.T) {
+	src := New(2)
+	comp := ComputedWithOptions(func() int {
+		return src.Get() * 10
+	}, Options[int]{
+		ComputeTimeout: time.Second,
+		FallbackValue:  -1,
+	}, src.AsReadonly())
+
+	if got := comp.Get(); got != 20 {
+		t.Errorf("Get() = %d, want 20", got)
+	}
+	src.Set(3)
+	if got := comp.Get(); got != 30 {
+		t.Errorf("Get() after Set = %d, want 30", got)
+	}
+}
+
+// TestComputed_ComputeTimeoutStaleResult verifies a change during a late compute reruns it once, dropping the stale result
+func TestComputed_ComputeTimeoutStaleResult(t *testing.T) {
+	src := New(1)
+	release := make(chan struct{})
+	comp := ComputedWithOptions(func() int {
+		v := src.Get()
+		if v == 1 {
+			<-release // Slow only for the first value
+		}
+		return v * 10
+	}, Options[int]{
+		ComputeTimeout: 10 * time.Millisecond,
+		FallbackValue:  -1,
+	}, src.AsReadonly())
+
+	if got := comp.Get(); got != -1 {
+		t.Fatalf("Get() = %d, want fallback -1", got)
+	}
+	var mu sync.Mutex
+	var notified []int
+	comp.SubscribeForever(func(v int) {
+		mu.Lock()
+		notified = append(notified, v)
+		mu.Unlock()
+	})
+
+	src.Set(2)
+	if got := comp.Get(); got != -1 {
+		t.Fatalf("Get() after Set = %d, want fallback -1 while the first compute runs", got)
+	}
+
+	close(release)
+	waitFor(t, func() bool { return comp.Get() == 20 })
+	mu.Lock()
+	defer mu.Unlock()
+	if want := []int{20}; !slices.Equal(notified, want) {
+		t.Errorf("notified %v, want %v", notified, want)
+	}
+}
+
+// TestComputed_ComputeTimeoutOneInFlight verifies changes during a late compute start no other compute
+// and are coalesced into one rerun
+func TestComputed_ComputeTimeoutOneInFlight(t *testing.T) {
+	src := New(0)
+	release := make(chan struct{})
+	var running, maxRunning, computes atomic.Int32
+	comp := ComputedWithOptions(func() int {
+		n := running.Add(1)
+		defer running.Add(-1)
+		if n > maxRunning.Load() {
+			maxRunning.Store(n)
+		}
+		computes.Add(1)
+		v := src.Get()
+		if v == 0 {
+			<-release
+		}
+		return v
+	}, Options[int]{
+		ComputeTimeout: 10 * time.Millisecond,
+		FallbackValue:  -1,
+	}, src.AsReadonly())
+
+	comp.Get() // Times out
+	for i := 1; i <= 5; i++ {
+		src.Set(i)
+		comp.Get()
+	}
+	if got := computes.Load(); got != 1 {
+		t.Errorf("computes = %d while the first runs, want 1", got)
+	}
+
+	close(release)
+	waitFor(t, func() bool { return comp.Get() == 5 })
+	if got := computes.Load(); got != 2 {
+		t.Errorf("computes = %d, want 2 (one rerun for all the changes)", got)
+	}
+	if got := maxRunning.Load(); got != 1 {
+		t.Errorf("computes in flight at once = %d, want 1", got)
+	}
+}
+
+// TestComputed_ComputeTimeoutClock verifies the deadline is measured by Options.Clock
+func TestComputed_ComputeTimeoutClock(t *testing.T) {
+	clock := NewFakeClock(time.Now())
+	release := make(chan struct{})
+	comp := ComputedWithOptions(func() int {
+		<-release
+		return 1
+	}, Options[int]{
+		ComputeTimeout: time.Second,
+		FallbackValue:  -1,
+		Clock:          clock,
+	})
+	defer close(release)
+
+	got := make(chan int)
+	go func() { got <- comp.Get() }()
+	waitFor(t, func() bool { return clock.Pending() == 1 })
+	select {
+	case v := <-got:
+		t.Fatalf("Get() = %d before the fake deadline", v)
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	clock.Advance(time.Second)
+	if v := <-got; v != -1 {
+		t.Errorf("Get() = %d, want fallback -1", v)
+	}
+}
+
+// commitWithoutNotify writes v to sig and returns the write's notifications
+// unsent, so tests can commit several writes before any are delivered.
+func commitWithoutNotify(sig Signal[int], v int) func() {
+	s := sig.(*signal[int])
+	_, w, _ := s.commitUpdate(always(func(int) int { return v }), nil)
+	return func() {
+		s.notifyReactions(w.reactions, nil)
+		if w.deliver {
+			s.deliver(w.callbacks, v, nil, nil)
+		}
+	}
+}
+
+// TestComputed_SkipsSeenDependencyChanges verifies a computed recomputes once when
+// several dependencies change before their notifications arrive
+func TestComputed_SkipsSeenDependencyChanges(t *testing.T) {
+	a, b, c := New(1), New(2), New(3)
+	var computes int32
+	sum := Computed(func() int {
+		atomic.AddInt32(&computes, 1)
+		return a.Get() + b.Get() + c.Get()
+	}, a.AsReadonly(), b.AsReadonly(), c.AsReadonly())
+
+	var notified []int
+	sum.SubscribeForever(func(v int) { notified = append(notified, v) })
+	sum.Get()
+	atomic.StoreInt32(&computes, 0)
+
+	// All three writes commit, then their notifications run
+	notify := []func(){
+		commitWithoutNotify(a, 10),
+		commitWithoutNotify(b, 20),
+		commitWithoutNotify(c, 30),
+	}
+	for _, fn := range notify {
+		fn()
+	}
+
+	if got := atomic.LoadInt32(&computes); got != 1 {
+		t.Errorf("computes = %d, want 1", got)
+	}
+	if got := sum.Get(); got != 60 {
+		t.Errorf("Get() = %d, want 60", got)
+	}
+	if len(notified) != 1 || notified[0] != 60 {
+		t.Errorf("notifications = %v, want [60]", notified)
+	}
+
+	// A later change still recomputes
+	b.Set(0)
+	if got := sum.Get(); got != 40 {
+		t.Errorf("Get() after Set = %d, want 40", got)
+	}
+	if got := atomic.LoadInt32(&computes); got != 2 {
+		t.Errorf("computes = %d, want 2", got)
+	}
+}
+
+// TestComputed_VersionsThroughComputed verifies computed dependencies are versioned too
+func TestComputed_VersionsThroughComputed(t *testing.T) {
+	a := New(1)
+	double := Computed(func() int { return a.Get() * 2 }, a.AsReadonly())
+	var computes int32
+	plus := Computed(func() int {
+		atomic.AddInt32(&computes, 1)
+		return double.Get() + 1
+	}, double)
+
+	plus.SubscribeForever(func(int) {})
+	plus.Get()
+	a.Set(2)
+	a.Set(3)
+
+	if got := plus.Get(); got != 7 {
+		t.Errorf("Get() = %d, want 7", got)
+	}
+	if got := atomic.LoadInt32(&computes); got != 3 {
+		t.Errorf("computes = %d, want 3", got)
+	}
+}
+
+// TestComputed_TryGet verifies TryGet surfaces the latest compute panic alongside the stale value
+func TestComputed_TryGet(t *testing.T) {
+	src := New(1)
+	comp := ComputedWithOptions(func() int {
+		v := src.Get()
+		if v < 0 {
+			panic("negative input")
+		}
+		return v * 10
+	}, Options[int]{OnPanic: func(any, []byte) {}}, src.AsReadonly())
+
+	tg, ok := comp.(TryGetter[int])
+	if !ok {
+		t.Fatal("computed does not implement TryGetter")
+	}
+
+	if v, err := tg.TryGet(); v != 10 || err != nil {
+		t.Errorf("TryGet() = %d, %v; want 10, nil", v, err)
+	}
+
+	src.Set(-1)
+	v, err := tg.TryGet()
+	if v != 10 {
+		t.Errorf("TryGet() value = %d, want stale 10", v)
+	}
+	var pe *PanicError
+	if !errors.As(err, &pe) || pe.Value != "negative input" {
+		t.Errorf("TryGet() error = %v, want PanicError for the compute panic", err)
+	}
+
+	src.Set(2)
+	if v, err := tg.TryGet(); v != 20 || err != nil {
+		t.Errorf("TryGet() after recovery = %d, %v; want 20, nil", v, err)
+	}
+}
+
+// TestComputed_IsDegraded verifies IsDegraded reflects whether the latest compute panicked
+func TestComputed_IsDegraded(t *testing.T) {
+	src := New(1)
+	comp := ComputedWithOptions(func() int {
+		if v := src.Get(); v >= 0 {
+			return v
+		}
+		panic("negative input")
+	}, Options[int]{OnPanic: func(any, []byte) {}}, src.AsReadonly())
+	defer comp.Cleanup()
+
+	dc, ok := comp.(DegradedChecker)
+	if !ok {
+		t.Fatal("computed does not implement DegradedChecker")
+	}
+	comp.Get()
+
+	for _, step := range []struct {
+		input    int
+		degraded bool
+		value    int
+	}{
+		{-1, true, 1},
+		{2, false, 2},
+		{-2, true, 2},
+		{-3, true, 2},
+		{3, false, 3},
+	} {
+		src.Set(step.input)
+		if v := comp.Get(); v != step.value || dc.IsDegraded() != step.degraded {
+			t.Errorf("input %d: Get() = %d, IsDegraded() = %v; want %d, %v",
+				step.input, v, dc.IsDegraded(), step.value, step.degraded)
+		}
+	}
+}
+
+// TestComputed_HasComputed verifies HasComputed tells a never-evaluated computed from one evaluated to zero
+func TestComputed_HasComputed(t *testing.T) {
+	src := New(0)
+	comp := ComputedWithOptions(func() int {
+		if v := src.Get(); v >= 0 {
+			return v
+		}
+		panic("negative input")
+	}, Options[int]{OnPanic: func(any, []byte) {}}, src.AsReadonly())
+	defer comp.Cleanup()
+
+	cc, ok := comp.(ComputedChecker)
+	if !ok {
+		t.Fatal("computed does not implement ComputedChecker")
+	}
+	if cc.HasComputed() {
+		t.Error("HasComputed() = true before any Get")
+	}
+	if cc.HasComputed() {
+		t.Error("HasComputed() forced an evaluation")
+	}
+
+	if v := comp.Get(); v != 0 || !cc.HasComputed() {
+		t.Errorf("Get() = %d, HasComputed() = %v; want 0, true", v, cc.HasComputed())
+	}
+	src.Set(-1) // A panicking compute doesn't reset it
+	comp.Get()
+	if !cc.HasComputed() {
+		t.Error("HasComputed() = false after a panicking recompute")
+	}
+
+	never := ComputedWithOptions(func() int { panic("always") },
+		Options[int]{OnPanic: func(any, []byte) {}}, src.AsReadonly())
+	never.Get()
+	if never.(ComputedChecker).HasComputed() {
+		t.Error("HasComputed() = true for a compute that never returned")
+	}
+}
+
+// TestComputed_SetCompute verifies swapping the compute function notifies subscribers and dependents with its values
+func TestComputed_SetCompute(t *testing.T) {
+	price := New(100)
+	total := Computed(func() int { return price.Get() }, price.AsReadonly())
+	label := Computed(func() string { return fmt.Sprint("$", total.Get()) }, total)
+
+	var got []int
+	total.SubscribeForever(func(v int) { got = append(got, v) })
+	var labels []string
+	eff := Effect(func() { labels = append(labels, label.Get()) }, label)
+	defer eff.Stop()
+
+	cs, ok := total.(ComputeSetter[int])
+	if !ok {
+		t.Fatal("computed does not implement ComputeSetter")
+	}
+	cs.SetCompute(func() int { return price.Get() * 9 / 10 })
+	price.Set(200) // Dependencies are kept
+
+	if want := []int{90, 180}; !slices.Equal(got, want) {
+		t.Errorf("subscriber saw %v, want %v", got, want)
+	}
+	if want := []string{"$100", "$90", "$180"}; !slices.Equal(labels, want) {
+		t.Errorf("effect saw %v, want %v", labels, want)
+	}
+}
+
+// TestComputed_SetComputeUnobserved verifies an unobserved computed picks up the new function on the next Get
+func TestComputed_SetComputeUnobserved(t *testing.T) {
+	n := New(2)
+	comp := Computed(func() int { return n.Get() + 1 }, n.AsReadonly())
+	comp.Get()
+
+	comp.(ComputeSetter[int]).SetCompute(func() int { return n.Get() * 10 })
+	if got := comp.Get(); got != 20 {
+		t.Errorf("Get() = %d, want 20", got)
+	}
+}
+
+// TestComputed_OnTiming verifies OnTiming reports compute and subscriber notify durations
+func TestComputed_OnTiming(t *testing.T) {
+	const slow = 20 * time.Millisecond
+
+	var mu sync.Mutex
+	timings := map[string][]time.Duration{}
+	a := New(1)
+	doubled := ComputedWithOptions(func() int {
+		time.Sleep(slow)
+		return a.Get() * 2
+	}, Options[int]{
+		OnTiming: func(op string, d time.Duration) {
+			mu.Lock()
+			timings[op] = append(timings[op], d)
+			mu.Unlock()
+		},
+	}, a.AsReadonly())
+	doubled.SubscribeForever(func(int) { time.Sleep(slow) })
+
+	doubled.Get()
+	a.Set(2)
+
+	mu.Lock()
+	defer mu.Unlock()
+	if got := len(timings[TimingCompute]); got != 2 {
+		t.Errorf("got %d compute timings, want 2", got)
+	}
+	if got := len(timings[TimingNotify]); got != 1 {
+		t.Errorf("got %d notify timings, want 1", got)
+	}
+	for op, ds := range timings {
+		for _, d := range ds {
+			if d < slow {
+				t.Errorf("%s took %v, want at least %v", op, d, slow)
+			}
+		}
+	}
+}
+
+// TestComputed_ConcurrentGetsShareRecompute verifies concurrent Gets of a dirty computed compute once
+func TestComputed_ConcurrentGetsShareRecompute(t *testing.T) {
+	const readers = 16
+
+	a := New(1)
+	var computes atomic.Int32
+	release := make(chan struct{})
+	slow := Computed(func() int {
+		computes.Add(1)
+		<-release
+		return a.Get() * 2
+	}, a.AsReadonly())
+
+	var wg sync.WaitGroup
+	results := make([]int, readers)
+	for i := range readers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = slow.Get()
+		}()
+	}
+
+	// Let every reader reach the recompute, then finish it
+	waitFor(t, func() bool {
+		c := slow.(*computed[int])
+		return computes.Load() == 1 && c.waiters.Load() == readers-1
+	})
+	close(release)
+	wg.Wait()
+
+	if got := computes.Load(); got != 1 {
+		t.Errorf("computes = %d, want 1", got)
+	}
+	for i, v := range results {
+		if v != 2 {
+			t.Errorf("reader %d got %d, want 2", i, v)
+		}
+	}
+}
+
+// TestComputed_SkipRecompute verifies a compute raising SkipRecompute keeps the cached value without reporting a panic
+func TestComputed_SkipRecompute(t *testing.T) {
+	source := New(1)
+	var panics atomic.Int32
+	comp := ComputedWithOptions(func() int {
+		v := source.Get()
+		if v < 0 {
+			panic(SkipRecompute)
+		}
+		return v * 10
+	}, Options[int]{
+		OnPanic: func(any, []byte) { panics.Add(1) },
+	}, source.AsReadonly())
+
+	var notified []int
+	comp.SubscribeForever(func(v int) { notified = append(notified, v) })
+	if got := comp.Get(); got != 10 {
+		t.Fatalf("Get() = %d, want 10", got)
+	}
+
+	source.Set(-1)
+	if got := comp.Get(); got != 10 {
+		t.Errorf("Get() after skip = %d, want cached 10", got)
+	}
+	if v, err := comp.(TryGetter[int]).TryGet(); v != 10 || err != nil {
+		t.Errorf("TryGet() = (%d, %v), want (10, nil)", v, err)
+	}
+	if n := panics.Load(); n != 0 {
+		t.Errorf("OnPanic called %d times, want 0", n)
+	}
+
+	source.Set(2)
+	if got := comp.Get(); got != 20 {
+		t.Errorf("Get() after recovery = %d, want 20", got)
+	}
+	if want := []int{20}; !slices.Equal(notified, want) {
+		t.Errorf("notifications = %v, want %v (none for the skip)", notified, want)
+	}
+}
+
+// TestComputed_SkipRecomputeNotLogged verifies SkipRecompute is not logged without OnPanic
+func TestComputed_SkipRecomputeNotLogged(t *testing.T) {
+	h := &captureHandler{}
+	skip := New(false)
+	comp := ComputedWithOptions(func() string {
+		if skip.Get() {
+			panic(SkipRecompute)
+		}
+		return "fresh"
+	}, Options[string]{Logger: slog.New(h)}, skip.AsReadonly())
+
+	comp.Get()
+	skip.Set(true)
+	if got := comp.Get(); got != "fresh" {
+		t.Errorf("Get() = %q, want cached %q", got, "fresh")
+	}
+	if n := len(h.records); n != 0 {
+		t.Errorf("logged %d records, want 0", n)
+	}
+}
+
+// foreignSignal is a ReadonlySignal implemented outside the package, so
+// computeds track it through SubscribeForever rather than as a dependent.
+type foreignSignal[T any] struct {
+	inner Signal[T]
+}
+
+func (f foreignSignal[T]) Get() T { return f.inner.Get() }
+
+func (f foreignSignal[T]) Subscribe(ctx context.Context, fn func(T)) Unsubscribe {
+	return f.inner.Subscribe(ctx, fn)
+}
+
+func (f foreignSignal[T]) SubscribeForever(fn func(T)) Unsubscribe {
+	return f.inner.SubscribeForever(fn)
+}
+
+// TestDerive_HeterogeneousDependencies verifies Derive recomputes on changes to dependencies of uncommon types
+func TestDerive_HeterogeneousDependencies(t *testing.T) {
+	type account struct {
+		Name  string
+		Limit int
+	}
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	owner := New(account{Name: "ada", Limit: 10})
+	since := New(start)
+	count := New(1)
+
+	// Foreign wrappers exercise the type-erased tracking for any T
+	ownerDep := foreignSignal[account]{owner}
+	sinceDep := foreignSignal[time.Time]{since}
+
+	var notified atomic.Int32
+	summary := Derive(func() string {
+		return fmt.Sprintf("%s %d/%d %s", ownerDep.Get().Name, count.Get(), ownerDep.Get().Limit, sinceDep.Get().Format(time.DateOnly))
+	}, ownerDep, sinceDep, count.AsReadonly())
+	summary.SubscribeForever(func(string) { notified.Add(1) })
+
+	if got := summary.Get(); got != "ada 1/10 2024-01-01" {
+		t.Fatalf("Get() = %q", got)
+	}
+
+	owner.Set(account{Name: "bob", Limit: 5})
+	if got := summary.Get(); got != "bob 1/5 2024-01-01" {
+		t.Errorf("after struct change, Get() = %q", got)
+	}
+	since.Set(start.AddDate(0, 1, 0))
+	if got := summary.Get(); got != "bob 1/5 2024-02-01" {
+		t.Errorf("after time change, Get() = %q", got)
+	}
+	count.Set(2)
+	if got := summary.Get(); got != "bob 2/5 2024-02-01" {
+		t.Errorf("after int change, Get() = %q", got)
+	}
+	if got := notified.Load(); got != 3 {
+		t.Errorf("notified %d times, want 3", got)
+	}
+}
+
+// TestComputed_CoalesceWindow verifies a burst of dependency changes is notified once per window
+func TestComputed_CoalesceWindow(t *testing.T) {
+	source := New(0)
+	var computes atomic.Int32
+	comp := ComputedWithOptions(func() int {
+		computes.Add(1)
+		return source.Get() * 2
+	}, Options[int]{CoalesceWindow: 50 * time.Millisecond}, source.AsReadonly())
+
+	var mu sync.Mutex
+	var notified []int
+	comp.SubscribeForever(func(v int) {
+		mu.Lock()
+		notified = append(notified, v)
+		mu.Unlock()
+	})
+	comp.Get()
+	computes.Store(0)
+
+	for i := 1; i <= 100; i++ {
+		source.Set(i)
+	}
+	if n := computes.Load(); n != 0 {
+		t.Errorf("recomputed %d times during the burst, want 0", n)
+	}
+	if got := comp.Get(); got != 200 {
+		t.Errorf("Get() within the window = %d, want fresh 200", got)
+	}
+
+	waitFor(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(notified) > 0
+	})
+	time.Sleep(20 * time.Millisecond)
+	mu.Lock()
+	defer mu.Unlock()
+	if len(notified) > 3 {
+		t.Errorf("notified %d times for 100 changes, want a few", len(notified))
+	}
+	if last := notified[len(notified)-1]; last != 200 {
+		t.Errorf("last notification = %d, want 200", last)
+	}
+}
+
+// TestComputed_CoalesceWindowDownstream verifies effects below a coalesced computed run after the window
+func TestComputed_CoalesceWindowDownstream(t *testing.T) {
+	source := New(0)
+	comp := ComputedWithOptions(func() int { return source.Get() + 1 },
+		Options[int]{CoalesceWindow: 20 * time.Millisecond}, source.AsReadonly())
+	double := Computed(func() int { return comp.Get() * 2 }, comp)
+
+	var runs, last atomic.Int32
+	eff := Effect(func() {
+		runs.Add(1)
+		last.Store(int32(double.Get()))
+	}, double)
+	defer eff.Stop()
+
+	for i := 1; i <= 10; i++ {
+		source.Set(i)
+	}
+	waitFor(t, func() bool { return last.Load() == 22 })
+	if n := runs.Load(); n > 3 {
+		t.Errorf("effect ran %d times, want the initial run plus a few", n)
+	}
+}
+
+// TestComputed_SubscriberReadsComputed verifies a subscriber can Get its own computed during notification
+func TestComputed_SubscriberReadsComputed(t *testing.T) {
+	source := New(1)
+	comp := Computed(func() int { return source.Get() * 10 }, source.AsReadonly())
+
+	var mismatches atomic.Int32
+	comp.SubscribeForever(func(v int) {
+		if got := comp.Get(); got != v {
+			mismatches.Add(1)
+		}
+	})
+	comp.Get()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 2; i <= 50; i++ {
+			source.Set(i)
+		}
+	}()
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("deadlock: subscriber reading the computed blocked notification")
+	}
+
+	if n := mismatches.Load(); n != 0 {
+		t.Errorf("Get() inside the subscriber differed from the notified value %d times", n)
+	}
+	if got := comp.Get(); got != 500 {
+		t.Errorf("Get() = %d, want 500", got)
+	}
+}
+
+// TestComputed_SubscriberWritesDependency verifies a subscriber can write a dependency and read the computed
+func TestComputed_SubscriberWritesDependency(t *testing.T) {
+	source := New(1)
+	comp := Computed(func() int { return source.Get() * 10 }, source.AsReadonly())
+
+	var reads []int
+	comp.SubscribeForever(func(v int) {
+		if v < 30 {
+			source.Set(v/10 + 1) // Nested change from within the notification
+		}
+		reads = append(reads, comp.Get())
+	})
+	comp.Get()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		source.Set(2)
+	}()
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("deadlock: subscriber writing a dependency blocked notification")
+	}
+
+	if got := comp.Get(); got != 30 {
+		t.Errorf("Get() = %d, want 30", got)
+	}
+	for _, r := range reads {
+		if r != 30 {
+			t.Errorf("reads = %v, want every Get after the nested write to see 30", reads)
+			break
+		}
+	}
+}
+
+// TestComputed_CleanupThroughInterface verifies Cleanup on the returned ComputedSignal releases dependency subscriptions
+func TestComputed_CleanupThroughInterface(t *testing.T) {
+	a, b := New(1), New(2)
+	var sum ComputedSignal[int] = Computed(func() int { return a.Get() + b.Get() }, a.AsReadonly(), b.AsReadonly())
+	var notified atomic.Int32
+	sum.SubscribeForever(func(int) { notified.Add(1) })
+	sum.Get()
+
+	if d := a.Dependents(); d.Computed != 1 {
+		t.Fatalf("a.Dependents() = %+v before Cleanup, want 1 computed", d)
+	}
+	sum.Cleanup()
+
+	for _, sig := range []Signal[int]{a, b} {
+		if d := sig.Dependents(); d.Total() != 0 {
+			t.Errorf("Dependents() = %+v after Cleanup, want none", d)
+		}
+	}
+	a.Set(10)
+	if n := notified.Load(); n != 0 {
+		t.Errorf("notified %d times after Cleanup, want 0", n)
+	}
+	if got := sum.Get(); got != 3 {
+		t.Errorf("Get() after Cleanup = %d, want the last value 3", got)
+	}
+}
+
+// TestComputed_Equal verifies a computed with Equal doesn't notify recomputed values equal to the cached one
+func TestComputed_Equal(t *testing.T) {
+	n := New(2)
+	var computes int
+	sign := ComputedWithOptions(func() string {
+		computes++
+		if n.Get() < 0 {
+			return "negative"
+		}
+		return "positive"
+	}, Options[string]{Equal: func(a, b string) bool { return a == b }}, n.AsReadonly())
+
+	var notified []string
+	sign.SubscribeForever(func(s string) { notified = append(notified, s) })
+	downstream := Computed(func() int { return len(sign.Get()) }, sign)
+	downstream.Get()
+
+	n.Set(3)
+	n.Set(-1)
+	n.Set(-4)
+
+	if want := []string{"negative"}; !slices.Equal(notified, want) {
+		t.Errorf("notified %v, want %v", notified, want)
+	}
+	if computes != 4 {
+		t.Errorf("computed %d times, want 4 (Equal compares results, it doesn't skip computes)", computes)
+	}
+	if got := downstream.Get(); got != len("negative") {
+		t.Errorf("downstream Get() = %d, want %d", got, len("negative"))
+	}
+}
+
+// TestField_NotifiesOnFieldChange verifies a field signal only notifies when its field changes
+func TestField_NotifiesOnFieldChange(t *testing.T) {
+	type config struct {
+		Host string
+		Port int
+	}
+	cfg := New(config{Host: "a", Port: 80})
+	port := Field(cfg.AsReadonly(), func(c config) int { return c.Port })
+	defer port.Cleanup()
+
+	var got []int
+	port.SubscribeForever(func(p int) { got = append(got, p) })
+	port.Get()
+
+	cfg.Set(config{Host: "b", Port: 80})
+	cfg.Set(config{Host: "b", Port: 8080})
+	cfg.Set(config{Host: "c", Port: 8080})
+
+	if want := []int{8080}; !slices.Equal(got, want) {
+		t.Errorf("notified %v, want %v", got, want)
+	}
+	if p := port.Get(); p != 8080 {
+		t.Errorf("Get() = %d, want 8080", p)
+	}
+}
+
+// TestField_EqualOverride verifies Options.Equal replaces the default comparison
+func TestField_EqualOverride(t *testing.T) {
+	type doc struct{ Tags []string }
+	d := New(doc{Tags: []string{"x"}})
+
+	byIdentity := Field(d.AsReadonly(), func(v doc) []string { return v.Tags })
+	byContent := Field(d.AsReadonly(), func(v doc) []string { return v.Tags },
+		Options[[]string]{Equal: slices.Equal[[]string]})
+	defer byIdentity.Cleanup()
+	defer byContent.Cleanup()
+
+	var identity, content int
+	byIdentity.SubscribeForever(func([]string) { identity++ })
+	byContent.SubscribeForever(func([]string) { content++ })
+	byIdentity.Get()
+	byContent.Get()
+
+	d.Set(doc{Tags: []string{"x"}}) // Equal copy
+	byIdentity.Get()
+	byContent.Get()
+
+	if identity != 1 || content != 0 {
+		t.Errorf("notified %d by identity and %d by content, want 1 and 0", identity, content)
+	}
+}
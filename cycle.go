@@ -0,0 +1,130 @@
+package signals
+
+import (
+	"reflect"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// Cycle detection for computeds.
+//
+// A cycle shows up as a goroutine re-entering the Get of a computed whose
+// compute it is already running, while holding that computed's mutex.
+// Telling this apart from another goroutine holding the mutex requires
+// goroutine identity, which is expensive (see goroutineID). To keep the
+// common path free of it, recomputes are only identified when another
+// recompute is already in flight somewhere. That leaves at most one
+// unidentified recompute at any moment, and a goroutine can tell whether it
+// is running that one by counting recompute frames on its own stack.
+
+// recomputesInFlight counts recomputes running across all computeds.
+var recomputesInFlight atomic.Int64
+
+// recomputeOwners counts identified recomputes per goroutine ID.
+var recomputeOwners = struct {
+	sync.Mutex
+	byGoroutine map[uint64]int
+}{byGoroutine: make(map[uint64]int)}
+
+// recomputeFuncName is the runtime name shared by all instantiations of
+// (*computed[T]).recompute, used to recognize its frames.
+var recomputeFuncName = runtime.FuncForPC(reflect.ValueOf((*computed[int]).recompute).Pointer()).Name()
+
+// beginCompute records that c is recomputing on the calling goroutine.
+func (c *computed[T]) beginCompute() {
+	if recomputesInFlight.Add(1) > 1 {
+		gid := goroutineID()
+		c.owner.Store(gid)
+		addRecomputeOwner(gid, 1)
+	}
+	c.epoch.Add(1) // Odd while computing
+}
+
+// endCompute clears the record made by beginCompute, and wakes readers
+// waiting for the recompute (see awaitRecompute).
+func (c *computed[T]) endCompute() {
+	c.epoch.Add(1)
+	if gid := c.owner.Swap(0); gid != 0 {
+		addRecomputeOwner(gid, -1)
+	}
+	recomputesInFlight.Add(-1)
+
+	if c.waiters.Load() > 0 {
+		c.flightMu.Lock()
+		if c.flight != nil {
+			close(c.flight)
+			c.flight = nil
+		}
+		c.flightMu.Unlock()
+	}
+}
+
+// recomputingOnCaller reports whether c's compute is running on the calling goroutine.
+// Only called on the contended path, after TryLock fails.
+func (c *computed[T]) recomputingOnCaller() bool {
+	epoch := c.epoch.Load()
+	if epoch%2 == 0 {
+		return false // Mutex held for bookkeeping, not compute
+	}
+
+	var onCaller bool
+	if owner := c.owner.Load(); owner != 0 {
+		onCaller = owner == goroutineID()
+	} else if frames := countRecomputeFrames(); frames > 0 {
+		// c is the single unidentified recompute. The caller is running it
+		// iff its stack has a recompute frame not accounted for by its
+		// identified recomputes.
+		onCaller = frames > recomputesOwnedBy(goroutineID())
+	}
+
+	// The same compute must have been in flight during the whole check
+	return onCaller && c.epoch.Load() == epoch
+}
+
+// addRecomputeOwner adjusts the identified recompute count for a goroutine.
+func addRecomputeOwner(gid uint64, delta int) {
+	recomputeOwners.Lock()
+	defer recomputeOwners.Unlock()
+
+	n := recomputeOwners.byGoroutine[gid] + delta
+	if n == 0 {
+		delete(recomputeOwners.byGoroutine, gid)
+		return
+	}
+	recomputeOwners.byGoroutine[gid] = n
+}
+
+// recomputesOwnedBy returns the identified recompute count for a goroutine.
+func recomputesOwnedBy(gid uint64) int {
+	recomputeOwners.Lock()
+	defer recomputeOwners.Unlock()
+	return recomputeOwners.byGoroutine[gid]
+}
+
+// countRecomputeFrames counts recompute frames on the calling goroutine's stack.
+//
+// Frames are looked up with FuncForPC rather than expanded with
+// CallersFrames, which is much slower. That misses inlined calls, but
+// recompute has defers and is never inlined.
+func countRecomputeFrames() int {
+	var buf [64]uintptr
+	pcs := buf[:]
+	for {
+		n := runtime.Callers(0, pcs)
+		if n < len(pcs) {
+			pcs = pcs[:n]
+			break
+		}
+		pcs = make([]uintptr, 2*len(pcs))
+	}
+
+	count := 0
+	for _, pc := range pcs {
+		// Return addresses point just past the call, possibly into the next function
+		if fn := runtime.FuncForPC(pc - 1); fn != nil && fn.Name() == recomputeFuncName {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,109 @@
+package signals
+
+import (
+	"cmp"
+	"slices"
+	"sync"
+	"time"
+)
+
+// Delivery is what a subscriber last received, recorded with
+// Options.TrackDeliveries.
+type Delivery[T any] struct {
+	// ID identifies the subscriber; IDs increase in subscription order.
+	ID uint64
+
+	// Notified is false until the subscriber first receives a value.
+	Notified bool
+
+	// Time is when Value was delivered, zero if never notified.
+	Time time.Time
+
+	// Value is the last value delivered.
+	Value T
+
+	// Panics counts the deliveries the subscriber panicked on.
+	Panics int
+}
+
+// deliveries records the last delivery to each subscriber. It has its own
+// lock because subscribers run outside the signal's mu.
+type deliveries[T any] struct {
+	mu   sync.Mutex
+	byID map[uint64]*Delivery[T]
+}
+
+// newDeliveries returns deliveries for Options, or nil if tracking is off.
+func newDeliveries[T any](opts Options[T]) *deliveries[T] {
+	if !opts.TrackDeliveries {
+		return nil
+	}
+	return &deliveries[T]{byID: make(map[uint64]*Delivery[T])}
+}
+
+// track starts recording subscriber id and returns fn wrapped to record
+// each delivery.
+func (d *deliveries[T]) track(id uint64, fn func(T)) func(T) {
+	d.mu.Lock()
+	d.byID[id] = &Delivery[T]{ID: id}
+	d.mu.Unlock()
+
+	return func(v T) {
+		d.record(id, func(r *Delivery[T]) {
+			r.Notified, r.Time, r.Value = true, time.Now(), v
+		})
+		completed := false
+		defer func() {
+			if !completed { // Panicking: count it, the panic goes on
+				d.record(id, func(r *Delivery[T]) { r.Panics++ })
+			}
+		}()
+		fn(v)
+		completed = true
+	}
+}
+
+// record applies update to the delivery of subscriber id, if still tracked.
+func (d *deliveries[T]) record(id uint64, update func(*Delivery[T])) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if r, ok := d.byID[id]; ok {
+		update(r)
+	}
+}
+
+// remove stops recording subscriber id.
+func (d *deliveries[T]) remove(id uint64) {
+	d.mu.Lock()
+	delete(d.byID, id)
+	d.mu.Unlock()
+}
+
+// reset stops recording every subscriber.
+func (d *deliveries[T]) reset() {
+	d.mu.Lock()
+	clear(d.byID)
+	d.mu.Unlock()
+}
+
+// snapshot copies the deliveries, in subscription order.
+func (d *deliveries[T]) snapshot() []Delivery[T] {
+	d.mu.Lock()
+	out := make([]Delivery[T], 0, len(d.byID))
+	for _, r := range d.byID {
+		out = append(out, *r)
+	}
+	d.mu.Unlock()
+
+	slices.SortFunc(out, func(a, b Delivery[T]) int { return cmp.Compare(a.ID, b.ID) })
+	return out
+}
+
+// Deliveries returns the last delivery to each current subscriber, in
+// subscription order, or nil if Options.TrackDeliveries is off.
+func (s *signal[T]) Deliveries() []Delivery[T] {
+	if s.deliveries == nil {
+		return nil
+	}
+	return s.deliveries.snapshot()
+}
@@ -0,0 +1,73 @@
+package signals
+
+import (
+	"context"
+	"testing"
+)
+
+// TestDeliveries_RecordLastValue verifies each subscriber's last delivered value is recorded
+func TestDeliveries_RecordLastValue(t *testing.T) {
+	sig := NewWithOptions(0, Options[int]{
+		TrackDeliveries: true,
+		OnPanic:         func(any, []byte) {},
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sig.SubscribeForever(func(int) {})
+	sig.Subscribe(ctx, func(v int) {
+		if v == 2 {
+			panic("boom")
+		}
+	})
+	sig.Set(1)
+	sig.Set(2)
+	sig.SubscribeForever(func(int) {}) // Never notified
+
+	got := sig.(DeliveryReader[int]).Deliveries()
+	if len(got) != 3 {
+		t.Fatalf("recorded %d subscribers, want 3", len(got))
+	}
+	for i, d := range got[:2] {
+		if !d.Notified || d.Value != 2 || d.Time.IsZero() {
+			t.Errorf("subscriber %d: %+v, want notified with 2", i, d)
+		}
+	}
+	if got[0].Panics != 0 || got[1].Panics != 1 {
+		t.Errorf("panics = %d, %d, want 0, 1", got[0].Panics, got[1].Panics)
+	}
+	if d := got[2]; d.Notified || !d.Time.IsZero() {
+		t.Errorf("late subscriber: %+v, want never notified", d)
+	}
+	if !(got[0].ID < got[1].ID && got[1].ID < got[2].ID) {
+		t.Errorf("IDs %d, %d, %d not in subscription order", got[0].ID, got[1].ID, got[2].ID)
+	}
+}
+
+// TestDeliveries_Unsubscribe verifies unsubscribed subscribers are no longer reported
+func TestDeliveries_Unsubscribe(t *testing.T) {
+	sig := NewWithOptions("a", Options[string]{TrackDeliveries: true})
+	unsub := sig.SubscribeForever(func(string) {})
+	sig.SubscribeForever(func(string) {})
+	sig.Set("b")
+	unsub()
+
+	got := sig.(DeliveryReader[string]).Deliveries()
+	if len(got) != 1 || got[0].Value != "b" {
+		t.Errorf("Deliveries() = %+v, want the remaining subscriber with b", got)
+	}
+	if fork := sig.Fork().(DeliveryReader[string]); fork.Deliveries() == nil {
+		t.Error("fork does not track deliveries")
+	}
+}
+
+// TestDeliveries_Disabled verifies nothing is recorded by default
+func TestDeliveries_Disabled(t *testing.T) {
+	sig := New(0)
+	sig.SubscribeForever(func(int) {})
+	sig.Set(1)
+
+	if got := sig.(DeliveryReader[int]).Deliveries(); got != nil {
+		t.Errorf("Deliveries() = %+v, want nil", got)
+	}
+}
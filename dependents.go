@@ -0,0 +1,64 @@
+package signals
+
+import "fmt"
+
+// DependentKind identifies the kind of reactive node subscribed to a signal.
+type DependentKind int
+
+const (
+	// DependentComputed is a computed signal deriving from the source.
+	DependentComputed DependentKind = iota
+
+	// DependentEffect is an effect re-running when the source changes.
+	DependentEffect
+)
+
+// String returns a human-readable name for the dependent kind.
+func (k DependentKind) String() string {
+	switch k {
+	case DependentComputed:
+		return "computed"
+	case DependentEffect:
+		return "effect"
+	default:
+		return fmt.Sprintf("DependentKind(%d)", int(k))
+	}
+}
+
+// Dependents summarizes the computeds and effects currently subscribed to a signal.
+//
+// Only dependents registered through Computed or Effect deps are counted;
+// plain Subscribe callbacks are not included.
+//
+// Example:
+//
+//	d := count.Dependents()
+//	if d.Total() > 100 {
+//	    log.Printf("count drives %d computeds and %d effects", d.Computed, d.Effect)
+//	}
+type Dependents struct {
+	// Computed is the number of computed signals depending on the source.
+	Computed int
+
+	// Effect is the number of effects depending on the source.
+	Effect int
+}
+
+// Total returns the total number of dependents.
+func (d Dependents) Total() int {
+	return d.Computed + d.Effect
+}
+
+// countDependents tallies a dependents map by kind.
+func countDependents(kinds map[uint64]DependentKind) Dependents {
+	var d Dependents
+	for _, kind := range kinds {
+		switch kind {
+		case DependentComputed:
+			d.Computed++
+		case DependentEffect:
+			d.Effect++
+		}
+	}
+	return d
+}
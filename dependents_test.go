@@ -0,0 +1,73 @@
+package signals
+
+import "testing"
+
+// TestDependents_Computed verifies the count rises with a computed and falls after Cleanup
+func TestDependents_Computed(t *testing.T) {
+	count := New(0)
+
+	if got := count.Dependents(); got.Total() != 0 {
+		t.Fatalf("Initial Dependents() = %+v, want none", got)
+	}
+
+	comp := Computed(func() int { return count.Get() * 2 }, count.AsReadonly())
+
+	if got := count.Dependents(); got.Computed != 1 || got.Effect != 0 {
+		t.Errorf("After Computed, Dependents() = %+v, want 1 computed", got)
+	}
+
+	comp.(*computed[int]).Cleanup()
+
+	if got := count.Dependents(); got.Total() != 0 {
+		t.Errorf("After Cleanup, Dependents() = %+v, want none", got)
+	}
+}
+
+// TestDependents_Effect verifies effects are counted and removed on Stop
+func TestDependents_Effect(t *testing.T) {
+	count := New(0)
+
+	eff1 := Effect(func() { count.Get() }, count.AsReadonly())
+	eff2 := Effect(func() { count.Get() }, count) // Writable signal passed directly
+
+	if got := count.Dependents(); got.Effect != 2 || got.Computed != 0 {
+		t.Errorf("Dependents() = %+v, want 2 effects", got)
+	}
+
+	eff1.Stop()
+	eff2.Stop()
+
+	if got := count.Dependents(); got.Total() != 0 {
+		t.Errorf("After Stop, Dependents() = %+v, want none", got)
+	}
+}
+
+// TestDependents_PlainSubscribersExcluded verifies ordinary subscribers are not counted
+func TestDependents_PlainSubscribersExcluded(t *testing.T) {
+	count := New(0)
+	unsub := count.SubscribeForever(func(int) {})
+	defer unsub()
+
+	if got := count.Dependents(); got.Total() != 0 {
+		t.Errorf("Dependents() = %+v, want none for plain subscriber", got)
+	}
+}
+
+// TestDependents_ComputedChain verifies computeds report their own dependents
+func TestDependents_ComputedChain(t *testing.T) {
+	count := New(1)
+	doubled := Computed(func() int { return count.Get() * 2 }, count.AsReadonly())
+
+	eff := Effect(func() { doubled.Get() }, doubled)
+	defer eff.Stop()
+
+	if got := doubled.(*computed[int]).Dependents(); got.Effect != 1 {
+		t.Errorf("doubled.Dependents() = %+v, want 1 effect", got)
+	}
+
+	// Notifications still flow through the tracked subscription
+	count.Set(5)
+	if got := doubled.Get(); got != 10 {
+		t.Errorf("doubled.Get() = %d, want 10", got)
+	}
+}
@@ -0,0 +1,73 @@
+package signals
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sync/atomic"
+	"unsafe"
+)
+
+// ErrDuplicateSubscription is reported when a callback is subscribed to a
+// signal it is already subscribed to, while SetDuplicateSubscriptionCheck
+// is on.
+var ErrDuplicateSubscription = errors.New("signals: callback subscribed twice")
+
+// duplicateCheck is set while SetDuplicateSubscriptionCheck is on.
+var duplicateCheck atomic.Bool
+
+// SetDuplicateSubscriptionCheck turns on the development-mode check for
+// callbacks subscribed twice to the same signal, which then run twice per
+// change. Subscribing a callback the signal already has (by Subscribe,
+// SubscribeForever, SubscribeWithPriority, SubscribeMeta or Observe) is
+// reported as ErrDuplicateSubscription, naming the function, to the
+// signal's OnPanic (or logged). The subscription is still made.
+//
+// Callbacks are compared by identity, not by code pointer: the same func
+// value, or the same top-level function, is a duplicate, but two closures
+// created by one function literal are not, nor are two evaluations of a
+// method value. Only subscriptions made while the check is on are compared.
+//
+// The check is off by default and then costs a single atomic load per
+// Subscribe.
+//
+// Example:
+//
+//	func TestMain(m *testing.M) {
+//	    signals.SetDuplicateSubscriptionCheck(true)
+//	    os.Exit(m.Run())
+//	}
+func SetDuplicateSubscriptionCheck(enabled bool) {
+	duplicateCheck.Store(enabled)
+}
+
+// checkDuplicate reports callback, just subscribed as subscriber id, if
+// another subscriber has it too, see SetDuplicateSubscriptionCheck.
+func (s *signal[T]) checkDuplicate(id uint64, callback any) {
+	if !duplicateCheck.Load() {
+		return
+	}
+	identity := funcIdentity(reflect.ValueOf(callback))
+
+	s.mu.Lock()
+	if _, ok := s.subscribers[id]; !ok {
+		s.mu.Unlock()
+		return // Already unsubscribed
+	}
+	duplicate := false
+	for _, other := range s.callbackIDs {
+		if other == identity {
+			duplicate = true
+			break
+		}
+	}
+	if s.callbackIDs == nil {
+		s.callbackIDs = make(map[uint64]unsafe.Pointer)
+	}
+	s.callbackIDs[id] = identity
+	s.mu.Unlock()
+
+	if duplicate {
+		s.reportError(fmt.Errorf("%w: %s", ErrDuplicateSubscription, funcName(callback)))
+	}
+}
@@ -0,0 +1,68 @@
+package signals
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+// TestDuplicateSubscriptionCheck_Reports verifies subscribing the same callback twice is reported once and still subscribes it
+func TestDuplicateSubscriptionCheck_Reports(t *testing.T) {
+	SetDuplicateSubscriptionCheck(true)
+	defer SetDuplicateSubscriptionCheck(false)
+
+	var reported []error
+	sig := NewWithOptions(0, Options[int]{
+		OnPanic: func(err any, _ []byte) { reported = append(reported, err.(error)) },
+	})
+	calls := 0
+	onChange := func(int) { calls++ }
+	sig.SubscribeForever(onChange)
+	sig.(PrioritySubscriber[int]).SubscribeWithPriority(t.Context(), 1, onChange)
+
+	if len(reported) != 1 || !errors.Is(reported[0], ErrDuplicateSubscription) {
+		t.Fatalf("reported %v, want one ErrDuplicateSubscription", reported)
+	}
+	if msg := reported[0].Error(); !strings.Contains(msg, "TestDuplicateSubscriptionCheck_Reports") {
+		t.Errorf("error %q does not name the callback", msg)
+	}
+	sig.Set(1)
+	if calls != 2 {
+		t.Errorf("callback ran %d times, want 2 (the duplicate is still subscribed)", calls)
+	}
+
+	onMeta := func(int, any) {}
+	metaSub := sig.(MetaSubscriber[int])
+	metaSub.SubscribeMeta(t.Context(), onMeta)
+	metaSub.SubscribeMeta(t.Context(), onMeta)
+	if len(reported) != 2 {
+		t.Errorf("reported %d errors after a duplicate SubscribeMeta, want 2", len(reported))
+	}
+}
+
+// TestDuplicateSubscriptionCheck_Distinct verifies distinct closures, resubscriptions, and subscriptions with the check off are not reported
+func TestDuplicateSubscriptionCheck_Distinct(t *testing.T) {
+	var reported []any
+	sig := NewWithOptions(0, Options[int]{
+		OnPanic: func(err any, _ []byte) { reported = append(reported, err) },
+	})
+	onChange := func(int) {}
+	sig.SubscribeForever(onChange) // Check off: not recorded
+
+	SetDuplicateSubscriptionCheck(true)
+	defer SetDuplicateSubscriptionCheck(false)
+
+	sig.SubscribeForever(onChange)
+	for i := range 3 {
+		sig.SubscribeForever(func(v int) { _ = v + i }) // Same literal, distinct closures
+	}
+	unsub := sig.SubscribeForever(ignoreInt)
+	unsub()
+	sig.SubscribeForever(ignoreInt)
+
+	if len(reported) != 0 {
+		t.Errorf("reported %v, want nothing", reported)
+	}
+}
+
+func ignoreInt(int) {}
@@ -1,12 +1,24 @@
 package signals
 
 import (
-	"log"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
 	"runtime/debug"
+	"slices"
 	"sync"
 	"sync/atomic"
+	"time"
 )
 
+// ErrEffectTimeout is reported when an effect run exceeds EffectOptions.RunTimeout.
+var ErrEffectTimeout = errors.New("signals: effect run timed out")
+
+// ErrEffectReentrant is reported when an effect's function writes to one of
+// its own dependencies, which would otherwise re-run the effect recursively.
+var ErrEffectReentrant = errors.New("signals: effect wrote to its own dependency")
+
 // EffectRef represents a running side effect that can be stopped.
 //
 // Effects run immediately upon creation (Angular pattern) and re-run when dependencies change.
@@ -18,6 +30,33 @@ type EffectRef interface {
 	// After calling Stop, the effect will no longer run.
 	// Safe to call multiple times.
 	Stop()
+
+	// AddDependency subscribes the effect to dep and runs it once, so it
+	// picks up dep's current value. Adding a dependency the effect already
+	// has, or adding to a stopped effect, does nothing. A nil dep is
+	// reported as ErrNilDependency and ignored.
+	AddDependency(dep any)
+
+	// RemoveDependency unsubscribes the effect from dep. A signal and its
+	// AsReadonly views are the same dependency. Unknown deps are ignored.
+	RemoveDependency(dep any)
+
+	// RunCount returns how many times the effect function has run,
+	// including the initial run, to spot effects that run excessively.
+	RunCount() int64
+
+	// LastRunTime returns when the latest run started, or the zero Time if
+	// the effect hasn't run yet.
+	LastRunTime() time.Time
+}
+
+// effectDependency is a dependency subscription of a running effect.
+type effectDependency struct {
+	// key identifies the dependency (see dependencyKey)
+	key any
+
+	// unsubscribe cancels the subscription
+	unsubscribe Unsubscribe
 }
 
 // effect is the internal implementation of Effect.
@@ -28,8 +67,11 @@ type effect struct {
 	// cleanup is the current cleanup function from the last run
 	cleanup func()
 
-	// unsubscribes are cleanup functions for dependency subscriptions
-	unsubscribes []Unsubscribe
+	// deps are the current dependency subscriptions
+	deps []effectDependency
+
+	// depsMu protects deps
+	depsMu sync.Mutex
 
 	// mu protects cleanup field
 	mu sync.Mutex
@@ -37,8 +79,52 @@ type effect struct {
 	// stopped prevents effect from running after Stop()
 	stopped atomic.Bool
 
+	// completed is set by self-stopping effects once they are done
+	completed atomic.Bool
+
+	// pending holds goroutine IDs of runs requested while mu was held.
+	// Each is either a concurrent trigger (needing a follow-up run) or
+	// fn re-entering its own effect (which is dropped and reported).
+	pending []uint64
+
+	// pendingMu protects pending
+	pendingMu sync.Mutex
+
+	// pendingCount mirrors len(pending) so the uncontended path skips pendingMu
+	pendingCount atomic.Int32
+
+	// stats counts runs of fn, see RunCount
+	stats runStats
+
 	// onPanic is optional custom panic handler
 	onPanic func(any, []byte)
+
+	// logger is the fallback for panics without onPanic (nil means package log)
+	logger *slog.Logger
+
+	// runTimeout bounds each run of fn (zero means no limit)
+	runTimeout time.Duration
+
+	// onTimeout is called when a run exceeds runTimeout
+	onTimeout func(error)
+
+	// skipInitial defers the first run until a dependency changes
+	skipInitial bool
+
+	// debounce delays runs until dependencies are quiet (EffectOptions.Debounce);
+	// debounceInitial applies it to the initial run too
+	debounce        time.Duration
+	debounceInitial bool
+
+	// debounceTimer runs the effect once a quiet period ends; debouncePending
+	// is set while that run is counted by Settle. Both guarded by debounceMu.
+	debounceTimer   Timer
+	clock           Clock
+	debouncePending bool
+	debounceMu      sync.Mutex
+
+	// lastWave is the ID of the latest wave that reached this effect
+	lastWave atomic.Uint64
 }
 
 // Effect creates an effect that runs immediately and on dependency changes.
@@ -47,7 +133,12 @@ type effect struct {
 // whenever any dependency changes. This matches Angular's effect() behavior.
 //
 // Dependencies must be explicitly passed as additional arguments. Each dependency
-// can be a ReadonlySignal of any type.
+// can be a ReadonlySignal of any type. A nil dependency is skipped and reported
+// as ErrNilDependency (to OnPanic, or logged).
+//
+// An effect sees settled values: when a change reaches it through several
+// computeds (e.g., a signal and a computed of it), the effect runs once,
+// after all of them have recomputed.
 //
 // Example:
 //
@@ -84,6 +175,13 @@ func Effect(fn func(), deps ...any) EffectRef {
 //   - Before the next effect execution
 //   - When Stop() is called
 //
+// Runs and cleanups strictly alternate, even when dependencies are written
+// from many goroutines at once: each cleanup runs exactly once, after the
+// run that returned it and before the next run starts, and none of them
+// overlap. Concurrent changes may be coalesced into one run. The only
+// exception is a run abandoned at EffectOptions.RunTimeout, whose cleanup
+// runs whenever it finishes.
+//
 // This is useful for:
 //   - Canceling timers or intervals
 //   - Closing connections or file handles
@@ -123,6 +221,46 @@ type EffectOptions struct {
 	// OnPanic is called when the effect or cleanup function panics.
 	// If nil, panics are logged to stderr.
 	OnPanic func(err any, stack []byte)
+
+	// Logger receives panics and timeouts when OnPanic (or OnTimeout) is nil.
+	// If nil, they are written with the standard log package.
+	Logger *slog.Logger
+
+	// RunTimeout bounds how long a single run of the effect function may take.
+	// If zero, runs are unbounded (default).
+	//
+	// When set, the effect function runs on its own goroutine. If it doesn't
+	// finish within RunTimeout, the run is considered failed: OnTimeout fires
+	// with an error wrapping ErrEffectTimeout and the triggering Set returns.
+	// The function keeps running in the background; if it eventually returns
+	// a cleanup, that cleanup is executed immediately instead of being stored.
+	//
+	// Note: a timed-out run may still be executing when the next run starts.
+	RunTimeout time.Duration
+
+	// OnTimeout is called when a run exceeds RunTimeout.
+	// If nil, the timeout error is passed to OnPanic (or logged to stderr).
+	OnTimeout func(err error)
+
+	// SkipInitial skips the immediate run at creation. Dependencies are still
+	// subscribed, and the first run happens on the first dependency change.
+	// Stop behaves the same; if the effect never ran, there is no cleanup.
+	SkipInitial bool
+
+	// Debounce, if positive, collapses bursts of dependency changes: the
+	// effect runs once its dependencies have been quiet for Debounce, on a
+	// timer goroutine, instead of on every change. Cleanup still runs before
+	// each run, and Stop cancels a pending one.
+	//
+	// Use it for effects doing slow work on change, such as saving to disk.
+	Debounce time.Duration
+
+	// DebounceInitial delays the initial run by Debounce too, instead of
+	// running immediately. Only used with Debounce.
+	DebounceInitial bool
+
+	// Clock measures Debounce. If nil, the real clock is used.
+	Clock Clock
 }
 
 // EffectWithOptions creates an effect with custom options.
@@ -145,28 +283,243 @@ type EffectOptions struct {
 //	    count.AsReadonly(),
 //	)
 func EffectWithOptions(fn func() func(), opts EffectOptions, deps ...any) EffectRef {
-	e := &effect{
-		fn:      fn,
-		onPanic: opts.OnPanic,
+	e := newEffect(fn, opts)
+	e.start(deps)
+	return e
+}
+
+// EffectLazy creates an effect whose first run is deferred until a
+// dependency changes.
+//
+// Unlike Effect, fn is not called at creation. This avoids doing expensive
+// work with still-default initial values. It is shorthand for
+// EffectWithOptions with SkipInitial set.
+//
+// Example:
+//
+//	query := signals.New("")
+//
+//	eff := signals.EffectLazy(func() {
+//	    search(query.Get()) // Not called for the initial ""
+//	}, query.AsReadonly())
+//	defer eff.Stop()
+//
+//	query.Set("go") // First run
+func EffectLazy(fn func(), deps ...any) EffectRef {
+	return EffectWithOptions(func() func() {
+		fn()
+		return nil
+	}, EffectOptions{SkipInitial: true}, deps...)
+}
+
+// EffectOnce creates an effect that stops itself once fn reports completion.
+//
+// fn runs immediately and on every dependency change, like Effect, until it
+// returns true. After that run the effect calls Stop() on itself and never
+// runs again. This avoids manual Stop plumbing in one-shot initialization.
+//
+// Example:
+//
+//	ready := signals.New(false)
+//
+//	signals.EffectOnce(func() bool {
+//	    if !ready.Get() {
+//	        return false // Not yet - keep watching
+//	    }
+//	    initialize()
+//	    return true // Done - stop the effect
+//	}, ready.AsReadonly())
+func EffectOnce(fn func() bool, deps ...any) EffectRef {
+	var e *effect
+	e = newEffect(func() func() {
+		if fn() {
+			e.completed.Store(true)
+		}
+		return nil
+	}, EffectOptions{})
+	e.start(deps)
+	return e
+}
+
+// EffectWithContext creates an effect whose runs each get their own context,
+// canceled when the run is superseded: right before the cleanup that
+// precedes the next run, and on Stop. Use it to abort per-run background
+// work without plumbing cancellation through the cleanup by hand.
+//
+// fn may return a cleanup (or nil), called after the context is canceled.
+// If fn panics, its context is canceled right away.
+//
+// Example:
+//
+//	eff := signals.EffectWithContext(func(ctx context.Context) func() {
+//	    go fetchResults(ctx, query.Get()) // Abandoned when the query changes
+//	    return nil
+//	}, query.AsReadonly())
+//	defer eff.Stop()
+func EffectWithContext(fn func(ctx context.Context) func(), deps ...any) EffectRef {
+	return EffectWithCleanup(func() func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		returned := false
+		defer func() {
+			if !returned {
+				cancel()
+			}
+		}()
+
+		cleanup := fn(ctx)
+		returned = true
+		return func() {
+			cancel()
+			if cleanup != nil {
+				cleanup()
+			}
+		}
+	}, deps...)
+}
+
+// newEffect creates an effect without subscribing or running it.
+func newEffect(fn func() func(), opts EffectOptions) *effect {
+	return &effect{
+		fn:          fn,
+		onPanic:     opts.OnPanic,
+		logger:      opts.Logger,
+		runTimeout:  opts.RunTimeout,
+		onTimeout:   opts.OnTimeout,
+		skipInitial: opts.SkipInitial,
+
+		debounce:        opts.Debounce,
+		debounceInitial: opts.DebounceInitial,
+		clock:           clockOrReal(opts.Clock),
 	}
+}
 
+// start subscribes to deps and performs the initial run.
+func (e *effect) start(deps []any) {
 	// Track dependencies using type erasure (subscribe to changes)
-	for _, dep := range deps {
+	for _, dep := range nonNilDependencies(deps, e.reportError) {
 		e.trackDependency(dep)
 	}
 
+	// Lazy effects wait for the first dependency change
+	if e.skipInitial {
+		return
+	}
+	if e.debounce > 0 && e.debounceInitial {
+		e.scheduleRun()
+		return
+	}
+
 	// CRITICAL: Run effect IMMEDIATELY (Angular pattern)
 	// This MUST happen before returning the effect
 	e.run()
-
-	return e
 }
 
 // trackDependency registers a signal as a dependency using type erasure.
 // This subscribes to the dependency so the effect re-runs when it changes.
 func (e *effect) trackDependency(dep any) {
-	unsub := trackDependencyHelper(dep, e.run)
-	e.unsubscribes = append(e.unsubscribes, unsub)
+	unsub := trackDependentHelper(dep, DependentEffect, e)
+
+	e.depsMu.Lock()
+	e.deps = append(e.deps, effectDependency{key: dependencyKey(dep), unsubscribe: unsub})
+	e.depsMu.Unlock()
+}
+
+// stale schedules the effect to run once the wave's computeds have settled
+// (see wave.go). Other paths to it in the same wave are ignored.
+func (e *effect) stale(w *wave) {
+	if w.claim(&e.lastWave) {
+		w.reactions = append(w.reactions, e)
+	}
+}
+
+// settles reports false: effects don't need a wave to run.
+func (e *effect) settles() bool {
+	return false
+}
+
+// fire runs the effect for a dependency change, or schedules the run if
+// the effect is debounced.
+func (e *effect) fire() {
+	if e.debounce > 0 {
+		e.scheduleRun()
+		return
+	}
+	e.run()
+}
+
+// scheduleRun (re)starts the quiet period of a debounced effect, at the end
+// of which it runs.
+func (e *effect) scheduleRun() {
+	e.debounceMu.Lock()
+	defer e.debounceMu.Unlock()
+	if e.stopped.Load() {
+		return
+	}
+	if !e.debouncePending {
+		e.debouncePending = true
+		settling.add(1)
+	}
+	if e.debounceTimer == nil {
+		e.debounceTimer = e.clock.AfterFunc(e.debounce, e.runDebounced)
+		return
+	}
+	e.debounceTimer.Reset(e.debounce)
+}
+
+// runDebounced runs the effect at the end of a quiet period.
+func (e *effect) runDebounced() {
+	e.debounceMu.Lock()
+	counted := e.debouncePending
+	e.debouncePending = false
+	e.debounceMu.Unlock()
+
+	if counted {
+		defer settling.done(1)
+	}
+	e.run()
+}
+
+// AddDependency subscribes the effect to dep at runtime and runs it.
+func (e *effect) AddDependency(dep any) {
+	if isNilDependency(dep) {
+		e.reportError(ErrNilDependency)
+		return
+	}
+	key := dependencyKey(dep)
+
+	e.depsMu.Lock()
+	if e.stopped.Load() || e.indexOfDependency(key) >= 0 {
+		e.depsMu.Unlock()
+		return
+	}
+	unsub := trackDependentHelper(dep, DependentEffect, e)
+	e.deps = append(e.deps, effectDependency{key: key, unsubscribe: unsub})
+	e.depsMu.Unlock()
+
+	e.trigger()
+}
+
+// RemoveDependency unsubscribes the effect from dep at runtime.
+func (e *effect) RemoveDependency(dep any) {
+	e.depsMu.Lock()
+	i := e.indexOfDependency(dependencyKey(dep))
+	if i < 0 {
+		e.depsMu.Unlock()
+		return
+	}
+	unsub := e.deps[i].unsubscribe
+	e.deps = slices.Delete(e.deps, i, i+1)
+	e.depsMu.Unlock()
+
+	unsub()
+}
+
+// indexOfDependency returns the index of the dependency with key, or -1.
+// Caller must hold depsMu.
+func (e *effect) indexOfDependency(key any) int {
+	return slices.IndexFunc(e.deps, func(d effectDependency) bool {
+		return sameDependency(d.key, key)
+	})
 }
 
 // run executes the effect function with proper cleanup handling.
@@ -178,15 +531,143 @@ func (e *effect) trackDependency(dep any) {
 //  4. Store new cleanup (if returned)
 //
 // All steps have panic recovery to prevent one bad effect from breaking others.
+//
+// Runs never overlap. A run requested while another is in progress doesn't
+// block: it is recorded, and the goroutine holding mu re-runs the effect
+// once after the current run finishes. This coalesces concurrent triggers
+// and means a writer on another goroutine may return before the effect has
+// observed its write.
+//
+// If the effect function writes to one of its own dependencies, the resulting
+// nested request comes from the running goroutine itself. It is dropped and
+// reported as ErrEffectReentrant instead of deadlocking or looping forever.
 func (e *effect) run() {
 	// Don't run if stopped
 	if e.stopped.Load() {
 		return
 	}
 
-	e.mu.Lock()
+	if !e.acquire() {
+		// The goroutine holding mu will pick up the request
+		return
+	}
+	e.runAcquired()
+}
+
+// runAcquired runs the effect, then follows up on requests that arrived
+// meanwhile. Caller must hold mu; runAcquired releases it.
+func (e *effect) runAcquired() {
+	for {
+		e.runLocked()
+
+		// Self-stopping effects (EffectOnce) stop after the lock is released
+		if e.completed.Load() {
+			e.Stop()
+			return
+		}
+
+		// Follow up on requests that arrived during the run
+		if e.stopped.Load() || !e.hasPending() || !e.mu.TryLock() {
+			return
+		}
+	}
+}
+
+// acquire takes mu without blocking.
+// If mu is held, the request is recorded for the holder to pick up.
+func (e *effect) acquire() bool {
+	if e.mu.TryLock() {
+		return true
+	}
+
+	// Contended path only: identify the requester so fn re-entering its
+	// own effect can be told apart from a concurrent trigger
+	gid := goroutineID()
+	e.pendingMu.Lock()
+	e.pending = append(e.pending, gid)
+	e.pendingCount.Store(int32(len(e.pending)))
+	e.pendingMu.Unlock()
+
+	// The holder may have finished before the request was recorded
+	return e.mu.TryLock()
+}
+
+// trigger requests a run that is never attributed to fn re-entering the
+// effect, even if called from fn (e.g., AddDependency inside the effect).
+func (e *effect) trigger() {
+	if e.stopped.Load() {
+		return
+	}
+	if !e.mu.TryLock() {
+		// Goroutine ID 0 never matches in dropReentrant, so this is always
+		// treated as a concurrent trigger and gets a follow-up run
+		e.pendingMu.Lock()
+		e.pending = append(e.pending, 0)
+		e.pendingCount.Store(int32(len(e.pending)))
+		e.pendingMu.Unlock()
+
+		if !e.mu.TryLock() {
+			return
+		}
+	}
+	e.runAcquired()
+}
+
+// hasPending reports whether run requests are waiting for a follow-up run.
+func (e *effect) hasPending() bool {
+	return e.pendingCount.Load() > 0
+}
+
+// clearPending discards recorded requests; the run about to start satisfies them.
+func (e *effect) clearPending() {
+	if !e.hasPending() {
+		return
+	}
+	e.pendingMu.Lock()
+	e.pending = e.pending[:0]
+	e.pendingCount.Store(0)
+	e.pendingMu.Unlock()
+}
+
+// dropReentrant removes requests made by the goroutine that ran fn,
+// reporting ErrEffectReentrant if there were any. Runs on the fn goroutine.
+func (e *effect) dropReentrant() {
+	if !e.hasPending() {
+		return
+	}
+	e.pendingMu.Lock()
+	if len(e.pending) == 0 {
+		e.pendingMu.Unlock()
+		return
+	}
+
+	gid := goroutineID()
+	reentered := false
+	kept := e.pending[:0]
+	for _, id := range e.pending {
+		if id == gid {
+			reentered = true
+			continue
+		}
+		kept = append(kept, id)
+	}
+	e.pending = kept
+	e.pendingCount.Store(int32(len(kept)))
+	e.pendingMu.Unlock()
+
+	if reentered {
+		e.reportError(ErrEffectReentrant)
+	}
+}
+
+// runLocked performs one run: old cleanup, then fn.
+// Caller must hold mu; runLocked releases it.
+func (e *effect) runLocked() {
 	defer e.mu.Unlock()
 
+	// This run observes all changes requested so far
+	e.clearPending()
+
 	// Double-check after acquiring lock
 	if e.stopped.Load() {
 		return
@@ -198,38 +679,125 @@ func (e *effect) run() {
 		e.cleanup = nil
 
 		func() {
-			defer func() {
-				if r := recover(); r != nil {
-					if e.onPanic != nil {
-						e.onPanic(r, debug.Stack())
-					} else {
-						log.Printf("signals: panic in effect cleanup: %v\n%s", r, debug.Stack())
-					}
-				}
-			}()
+			defer e.recoverPanic("effect cleanup")
 			oldCleanup()
 		}()
 	}
 
 	// Step 2: Execute effect function and capture new cleanup
-	var newCleanup func()
-	func() {
-		defer func() {
-			if r := recover(); r != nil {
-				if e.onPanic != nil {
-					e.onPanic(r, debug.Stack())
-				} else {
-					log.Printf("signals: panic in effect function: %v\n%s", r, debug.Stack())
-				}
-			}
-		}()
-		newCleanup = e.fn()
-	}()
+	e.stats.record(e.clock)
+	newCleanup := e.execute()
 
 	// Step 3: Store new cleanup
 	e.cleanup = newCleanup
 }
 
+// RunCount returns how many times the effect function has run.
+func (e *effect) RunCount() int64 {
+	return e.stats.count()
+}
+
+// LastRunTime returns when the latest run started.
+func (e *effect) LastRunTime() time.Time {
+	return e.stats.lastRun()
+}
+
+// runStats counts the runs of an effect function, lock-free.
+type runStats struct {
+	runs atomic.Int64
+
+	// last is the start of the latest run, as Unix nanoseconds
+	last atomic.Int64
+}
+
+// record counts a run starting now.
+func (r *runStats) record(clock Clock) {
+	r.runs.Add(1)
+	r.last.Store(clock.Now().UnixNano())
+}
+
+func (r *runStats) count() int64 {
+	return r.runs.Load()
+}
+
+func (r *runStats) lastRun() time.Time {
+	if r.runs.Load() == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, r.last.Load())
+}
+
+// execute runs the effect function, enforcing runTimeout if configured.
+// Returns the cleanup produced by the run, or nil if it panicked or timed out.
+func (e *effect) execute() func() {
+	if e.runTimeout <= 0 {
+		return e.invoke()
+	}
+
+	result := make(chan func(), 1)
+	go func() {
+		result <- e.invoke()
+	}()
+
+	timer := time.NewTimer(e.runTimeout)
+	defer timer.Stop()
+
+	select {
+	case cleanup := <-result:
+		return cleanup
+	case <-timer.C:
+		e.reportTimeout()
+
+		// Release resources of the abandoned run once it finishes
+		go func() {
+			if late := <-result; late != nil {
+				defer e.recoverPanic("timed-out effect cleanup")
+				late()
+			}
+		}()
+		return nil
+	}
+}
+
+// invoke calls the effect function with panic recovery.
+// Afterwards, requests made by fn itself are dropped as reentrant.
+func (e *effect) invoke() (cleanup func()) {
+	defer e.recoverPanic("effect function")
+	defer e.dropReentrant()
+	return e.fn()
+}
+
+// reportTimeout delivers a timeout error to OnTimeout, falling back to reportError.
+func (e *effect) reportTimeout() {
+	err := fmt.Errorf("%w after %v", ErrEffectTimeout, e.runTimeout)
+	if e.onTimeout != nil {
+		e.onTimeout(err)
+		return
+	}
+	e.reportError(err)
+}
+
+// reportError delivers a non-panic failure to onPanic or the log.
+func (e *effect) reportError(err error) {
+	if e.onPanic != nil {
+		e.onPanic(err, debug.Stack())
+	} else {
+		logError(e.logger, err)
+	}
+}
+
+// recoverPanic recovers a panic and reports it to onPanic or the log.
+// Must be called directly via defer.
+func (e *effect) recoverPanic(where string) {
+	if r := recover(); r != nil {
+		if e.onPanic != nil {
+			e.onPanic(r, debug.Stack())
+		} else {
+			logPanic(e.logger, where, r, debug.Stack())
+		}
+	}
+}
+
 // Stop stops the effect and runs final cleanup.
 //
 // After calling Stop:
@@ -255,6 +823,17 @@ func (e *effect) Stop() {
 		return
 	}
 
+	// Cancel a pending debounced run
+	e.debounceMu.Lock()
+	if e.debounceTimer != nil {
+		e.debounceTimer.Stop()
+	}
+	if e.debouncePending {
+		e.debouncePending = false
+		settling.done(1)
+	}
+	e.debounceMu.Unlock()
+
 	e.mu.Lock()
 	defer e.mu.Unlock()
 
@@ -264,22 +843,18 @@ func (e *effect) Stop() {
 		e.cleanup = nil
 
 		func() {
-			defer func() {
-				if r := recover(); r != nil {
-					if e.onPanic != nil {
-						e.onPanic(r, debug.Stack())
-					} else {
-						log.Printf("signals: panic in final effect cleanup: %v\n%s", r, debug.Stack())
-					}
-				}
-			}()
+			defer e.recoverPanic("final effect cleanup")
 			cleanup()
 		}()
 	}
 
 	// Unsubscribe from all dependencies
-	for _, unsub := range e.unsubscribes {
-		unsub()
+	e.depsMu.Lock()
+	deps := e.deps
+	e.deps = nil
+	e.depsMu.Unlock()
+
+	for _, dep := range deps {
+		dep.unsubscribe()
 	}
-	e.unsubscribes = nil
 }
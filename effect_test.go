@@ -1,8 +1,11 @@
 package signals
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"runtime"
+	"slices"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -554,6 +557,54 @@ func TestEffect_CleanupOrder(t *testing.T) {
 	mu.Unlock()
 }
 
+// TestEffect_CleanupOrderConcurrent verifies runs and cleanups strictly alternate under concurrent writes
+func TestEffect_CleanupOrderConcurrent(t *testing.T) {
+	count := New(0)
+	var mu sync.Mutex
+	var events []int // +n for run n, -n for its cleanup
+	var runs int
+
+	eff := EffectWithCleanup(
+		func() func() {
+			mu.Lock()
+			runs++
+			run := runs
+			events = append(events, run)
+			mu.Unlock()
+			count.Get()
+
+			return func() {
+				mu.Lock()
+				events = append(events, -run)
+				mu.Unlock()
+			}
+		},
+		count.AsReadonly(),
+	)
+
+	var wg sync.WaitGroup
+	for range 20 {
+		wg.Go(func() {
+			for range 50 {
+				count.Update(func(v int) int { return v + 1 })
+			}
+		})
+	}
+	wg.Wait()
+	eff.Stop()
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(events) != 2*runs {
+		t.Fatalf("%d events for %d runs, want a cleanup per run", len(events), runs)
+	}
+	for i := 0; i < len(events); i += 2 {
+		if n := i/2 + 1; events[i] != n || events[i+1] != -n {
+			t.Fatalf("events[%d:%d] = %v, want [%d %d] (run, then its cleanup)", i, i+2, events[i:i+2], n, -n)
+		}
+	}
+}
+
 // TestEffect_CleanupPanic_DefaultHandler verifies cleanup panic recovery without custom handler
 func TestEffect_CleanupPanic_DefaultHandler(t *testing.T) {
 	count := New(0)
@@ -691,3 +742,552 @@ func TestEffect_WithComputedAndMultipleTypes(t *testing.T) {
 	}
 	mu.Unlock()
 }
+
+// TestEffect_RunTimeout verifies a runaway effect run is abandoned and reported.
+func TestEffect_RunTimeout(t *testing.T) {
+	count := New(0)
+	block := atomic.Bool{}
+	timeouts := atomic.Int32{}
+	var timeoutErr atomic.Value
+
+	eff := EffectWithOptions(
+		func() func() {
+			count.Get()
+			if block.Load() {
+				time.Sleep(200 * time.Millisecond)
+			}
+			return nil
+		},
+		EffectOptions{
+			RunTimeout: 20 * time.Millisecond,
+			OnTimeout: func(err error) {
+				timeouts.Add(1)
+				timeoutErr.Store(err)
+			},
+		},
+		count.AsReadonly(),
+	)
+	defer eff.Stop()
+
+	if timeouts.Load() != 0 {
+		t.Fatalf("Expected no timeout on fast initial run, got %d", timeouts.Load())
+	}
+
+	block.Store(true)
+	start := time.Now()
+	count.Set(1) // Must return even though the effect blocks
+	elapsed := time.Since(start)
+
+	if elapsed >= 200*time.Millisecond {
+		t.Fatalf("Set blocked for %v, expected it to return after RunTimeout", elapsed)
+	}
+	if timeouts.Load() != 1 {
+		t.Fatalf("Expected 1 timeout, got %d", timeouts.Load())
+	}
+	if err, _ := timeoutErr.Load().(error); !errors.Is(err, ErrEffectTimeout) {
+		t.Fatalf("Expected ErrEffectTimeout, got %v", err)
+	}
+}
+
+// TestEffect_RunTimeout_LateCleanup verifies cleanup returned by a timed-out run is still executed.
+func TestEffect_RunTimeout_LateCleanup(t *testing.T) {
+	count := New(0)
+	block := atomic.Bool{}
+	lateCleanups := atomic.Int32{}
+
+	eff := EffectWithOptions(
+		func() func() {
+			if !block.Load() {
+				return nil
+			}
+			time.Sleep(50 * time.Millisecond)
+			return func() { lateCleanups.Add(1) }
+		},
+		EffectOptions{
+			RunTimeout: 10 * time.Millisecond,
+			OnTimeout:  func(error) {},
+		},
+		count.AsReadonly(),
+	)
+	defer eff.Stop()
+
+	block.Store(true)
+	count.Set(1)
+
+	time.Sleep(100 * time.Millisecond)
+
+	if lateCleanups.Load() != 1 {
+		t.Fatalf("Expected late cleanup to run once, got %d", lateCleanups.Load())
+	}
+}
+
+// TestEffect_ReentrantWrite verifies an effect writing to its own dependency doesn't deadlock.
+func TestEffect_ReentrantWrite(t *testing.T) {
+	count := New(0)
+	runs := atomic.Int32{}
+	reported := atomic.Int32{}
+
+	done := make(chan EffectRef)
+	go func() {
+		eff := EffectWithOptions(
+			func() func() {
+				runs.Add(1)
+				count.Set(count.Get() + 1) // Writes to own dependency
+				return nil
+			},
+			EffectOptions{
+				OnPanic: func(err any, _ []byte) {
+					if e, ok := err.(error); ok && errors.Is(e, ErrEffectReentrant) {
+						reported.Add(1)
+					}
+				},
+			},
+			count.AsReadonly(),
+		)
+		count.Set(10)
+		done <- eff
+	}()
+
+	select {
+	case eff := <-done:
+		defer eff.Stop()
+	case <-time.After(time.Second):
+		t.Fatal("Effect writing to its own dependency deadlocked")
+	}
+
+	if got := runs.Load(); got != 2 {
+		t.Errorf("Expected 2 runs (initial + external Set), got %d", got)
+	}
+	if got := reported.Load(); got != 2 {
+		t.Errorf("Expected 2 reentrancy reports, got %d", got)
+	}
+	if got := count.Get(); got != 11 {
+		t.Errorf("count = %d, want 11", got)
+	}
+}
+
+// TestEffectOnce_StopsAfterCompletion verifies the effect stops once fn returns true.
+func TestEffectOnce_StopsAfterCompletion(t *testing.T) {
+	ready := New(false)
+	runs := atomic.Int32{}
+	completions := atomic.Int32{}
+
+	eff := EffectOnce(
+		func() bool {
+			runs.Add(1)
+			if !ready.Get() {
+				return false
+			}
+			completions.Add(1)
+			return true
+		},
+		ready.AsReadonly(),
+	)
+	defer eff.Stop()
+
+	if runs.Load() != 1 {
+		t.Fatalf("Expected 1 initial run, got %d", runs.Load())
+	}
+
+	ready.Set(true)
+	if completions.Load() != 1 {
+		t.Fatalf("Expected 1 completion, got %d", completions.Load())
+	}
+
+	// Further changes must not re-run the effect
+	ready.Set(false)
+	ready.Set(true)
+	if runs.Load() != 2 {
+		t.Fatalf("Expected 2 runs total, got %d", runs.Load())
+	}
+	if got := ready.Dependents(); got.Effect != 0 {
+		t.Errorf("Expected effect to unsubscribe after completion, got %+v", got)
+	}
+}
+
+// TestEffectOnce_CompletesImmediately verifies an effect done on its first run never re-runs.
+func TestEffectOnce_CompletesImmediately(t *testing.T) {
+	count := New(0)
+	runs := atomic.Int32{}
+
+	EffectOnce(
+		func() bool {
+			runs.Add(1)
+			return true
+		},
+		count.AsReadonly(),
+	)
+
+	count.Set(1)
+	count.Set(2)
+
+	if runs.Load() != 1 {
+		t.Fatalf("Expected exactly 1 run, got %d", runs.Load())
+	}
+}
+
+// TestEffect_ConcurrentTriggersFollowUp verifies triggers arriving mid-run are not lost.
+func TestEffect_ConcurrentTriggersFollowUp(t *testing.T) {
+	count := New(0)
+	lastSeen := atomic.Int64{}
+	active := atomic.Int32{}
+	overlaps := atomic.Int32{}
+
+	eff := Effect(
+		func() {
+			if active.Add(1) > 1 {
+				overlaps.Add(1)
+			}
+			lastSeen.Store(int64(count.Get()))
+			time.Sleep(time.Millisecond)
+			active.Add(-1)
+		},
+		count.AsReadonly(),
+	)
+	defer eff.Stop()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			count.Update(func(v int) int { return v + 1 })
+		}()
+	}
+	wg.Wait()
+
+	// Follow-up runs happen on whichever goroutine held the effect
+	deadline := time.Now().Add(time.Second)
+	for lastSeen.Load() != 50 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	if got := lastSeen.Load(); got != 50 {
+		t.Errorf("Effect last observed %d, want 50", got)
+	}
+	if got := overlaps.Load(); got != 0 {
+		t.Errorf("Effect runs overlapped %d times, want 0", got)
+	}
+}
+
+// TestEffect_AddDependency verifies a live effect reacts to a dependency added later
+func TestEffect_AddDependency(t *testing.T) {
+	a := New(1)
+	b := New(10)
+
+	var runs, last int32
+	eff := Effect(func() {
+		atomic.AddInt32(&runs, 1)
+		atomic.StoreInt32(&last, int32(a.Get()+b.Get()))
+	}, a.AsReadonly())
+	defer eff.Stop()
+
+	b.Set(20)
+	if got := atomic.LoadInt32(&runs); got != 1 {
+		t.Fatalf("runs = %d before AddDependency, want 1", got)
+	}
+
+	eff.AddDependency(b.AsReadonly())
+	if got := atomic.LoadInt32(&runs); got != 2 {
+		t.Errorf("runs = %d after AddDependency, want 2 (immediate run)", got)
+	}
+
+	b.Set(30)
+	if got := atomic.LoadInt32(&last); got != 31 {
+		t.Errorf("last = %d after b.Set(30), want 31", got)
+	}
+
+	eff.AddDependency(b) // Same signal as its readonly view: ignored
+	if got := atomic.LoadInt32(&runs); got != 3 {
+		t.Errorf("runs = %d after duplicate AddDependency, want 3", got)
+	}
+}
+
+// TestEffect_RemoveDependency verifies a removed dependency no longer triggers runs
+func TestEffect_RemoveDependency(t *testing.T) {
+	a := New(1)
+	b := New(1)
+
+	var runs int32
+	eff := Effect(func() {
+		a.Get()
+		b.Get()
+		atomic.AddInt32(&runs, 1)
+	}, a.AsReadonly(), b.AsReadonly())
+	defer eff.Stop()
+
+	eff.RemoveDependency(b.AsReadonly())
+	eff.RemoveDependency(New(0)) // Unknown: ignored
+
+	b.Set(2)
+	if got := atomic.LoadInt32(&runs); got != 1 {
+		t.Errorf("runs = %d after removed dependency changed, want 1", got)
+	}
+	a.Set(2)
+	if got := atomic.LoadInt32(&runs); got != 2 {
+		t.Errorf("runs = %d after remaining dependency changed, want 2", got)
+	}
+	if d := b.Dependents(); d.Effect != 0 {
+		t.Errorf("b.Dependents().Effect = %d, want 0", d.Effect)
+	}
+}
+
+// TestEffect_AddDependencyFromEffect verifies adding a dependency inside the
+// effect is not reported as reentrancy and the effect settles
+func TestEffect_AddDependencyFromEffect(t *testing.T) {
+	a := New(1)
+	b := New(1)
+	var reports, runs int32
+
+	var eff EffectRef
+	eff = EffectWithOptions(func() func() {
+		atomic.AddInt32(&runs, 1)
+		if a.Get() > 1 && eff != nil {
+			eff.AddDependency(b.AsReadonly())
+		}
+		return nil
+	}, EffectOptions{
+		OnPanic: func(any, []byte) { atomic.AddInt32(&reports, 1) },
+	}, a.AsReadonly())
+	defer eff.Stop()
+
+	a.Set(2)
+	b.Set(2)
+
+	if got := atomic.LoadInt32(&reports); got != 0 {
+		t.Errorf("reports = %d, want 0", got)
+	}
+	// Initial, a.Set, follow-up for the added dependency, b.Set
+	if got := atomic.LoadInt32(&runs); got != 4 {
+		t.Errorf("runs = %d, want 4", got)
+	}
+}
+
+// TestEffect_AddDependencyAfterStop verifies stopped effects ignore new dependencies
+func TestEffect_AddDependencyAfterStop(t *testing.T) {
+	a := New(1)
+	var runs int32
+	eff := Effect(func() { atomic.AddInt32(&runs, 1) })
+	eff.Stop()
+
+	eff.AddDependency(a.AsReadonly())
+	a.Set(2)
+
+	if got := atomic.LoadInt32(&runs); got != 1 {
+		t.Errorf("runs = %d, want 1", got)
+	}
+	if d := a.Dependents(); d.Total() != 0 {
+		t.Errorf("Dependents() = %+v, want none", d)
+	}
+}
+
+// TestEffectLazy_NoInitialRun verifies lazy effects first run on a dependency change
+func TestEffectLazy_NoInitialRun(t *testing.T) {
+	count := New(0)
+	var runs int32
+	var seen int32
+
+	eff := EffectLazy(func() {
+		atomic.AddInt32(&runs, 1)
+		atomic.StoreInt32(&seen, int32(count.Get()))
+	}, count.AsReadonly())
+	defer eff.Stop()
+
+	if got := atomic.LoadInt32(&runs); got != 0 {
+		t.Fatalf("runs after creation = %d, want 0", got)
+	}
+
+	count.Set(5)
+
+	if got := atomic.LoadInt32(&runs); got != 1 {
+		t.Errorf("runs after Set = %d, want 1", got)
+	}
+	if got := atomic.LoadInt32(&seen); got != 5 {
+		t.Errorf("seen = %d, want 5", got)
+	}
+}
+
+// TestEffectWithOptions_SkipInitialStop verifies Stop on a lazy effect before and after its first run
+func TestEffectWithOptions_SkipInitialStop(t *testing.T) {
+	count := New(0)
+	var runs, cleanups int32
+
+	eff := EffectWithOptions(func() func() {
+		atomic.AddInt32(&runs, 1)
+		return func() { atomic.AddInt32(&cleanups, 1) }
+	}, EffectOptions{SkipInitial: true}, count.AsReadonly())
+
+	count.Set(1)
+	eff.Stop()
+	count.Set(2)
+
+	if got := atomic.LoadInt32(&runs); got != 1 {
+		t.Errorf("runs = %d, want 1", got)
+	}
+	if got := atomic.LoadInt32(&cleanups); got != 1 {
+		t.Errorf("cleanups = %d, want 1", got)
+	}
+
+	// Stopping before any run has no cleanup to call
+	never := EffectLazy(func() { t.Error("stopped lazy effect ran") }, count.AsReadonly())
+	never.Stop()
+	count.Set(3)
+	if d := count.Dependents(); d.Total() != 0 {
+		t.Errorf("Dependents() = %+v, want none", d)
+	}
+}
+
+// TestEffectWithContext_CanceledOnNextRun verifies the context of run N is canceled when run N+1 starts
+func TestEffectWithContext_CanceledOnNextRun(t *testing.T) {
+	count := New(0)
+	var contexts []context.Context
+	var order []string
+
+	eff := EffectWithContext(func(ctx context.Context) func() {
+		n := count.Get()
+		if len(contexts) > 0 && contexts[len(contexts)-1].Err() == nil {
+			t.Errorf("run %d started before the previous context was canceled", n)
+		}
+		contexts = append(contexts, ctx)
+		return func() { order = append(order, fmt.Sprintf("cleanup %d canceled=%v", n, ctx.Err() != nil)) }
+	}, count.AsReadonly())
+	defer eff.Stop()
+
+	count.Set(1)
+	if len(contexts) != 2 {
+		t.Fatalf("ran %d times, want 2", len(contexts))
+	}
+	if !errors.Is(contexts[0].Err(), context.Canceled) {
+		t.Errorf("first run's context err = %v, want canceled", contexts[0].Err())
+	}
+	if contexts[1].Err() != nil {
+		t.Errorf("current run's context err = %v, want nil", contexts[1].Err())
+	}
+	if want := []string{"cleanup 0 canceled=true"}; !slices.Equal(order, want) {
+		t.Errorf("cleanups = %v, want %v", order, want)
+	}
+}
+
+// TestEffectWithContext_CanceledOnStop verifies Stop cancels the latest run's context
+func TestEffectWithContext_CanceledOnStop(t *testing.T) {
+	var runCtx context.Context
+	eff := EffectWithContext(func(ctx context.Context) func() {
+		runCtx = ctx
+		return nil
+	})
+
+	if runCtx.Err() != nil {
+		t.Fatalf("context canceled before Stop: %v", runCtx.Err())
+	}
+	eff.Stop()
+	select {
+	case <-runCtx.Done():
+	default:
+		t.Error("context not canceled by Stop")
+	}
+}
+
+// TestEffectWithContext_Panic verifies a panicking run's context is canceled
+func TestEffectWithContext_Panic(t *testing.T) {
+	var runCtx context.Context
+	eff := EffectWithContext(func(ctx context.Context) func() {
+		runCtx = ctx
+		panic("boom")
+	})
+	defer eff.Stop()
+
+	if runCtx.Err() == nil {
+		t.Error("context of a panicking run not canceled")
+	}
+}
+
+// TestEffect_Debounce verifies a burst of changes runs the effect once, after cleanup, with the latest value
+func TestEffect_Debounce(t *testing.T) {
+	count := New(0)
+	var mu sync.Mutex
+	var events []string
+
+	eff := EffectWithOptions(func() func() {
+		v := count.Get()
+		mu.Lock()
+		events = append(events, fmt.Sprint("run-", v))
+		mu.Unlock()
+		return func() {
+			mu.Lock()
+			events = append(events, fmt.Sprint("cleanup-", v))
+			mu.Unlock()
+		}
+	}, EffectOptions{Debounce: 30 * time.Millisecond}, count.AsReadonly())
+	defer eff.Stop()
+
+	for i := 1; i <= 100; i++ {
+		count.Set(i)
+	}
+	if err := Settle(settleCtx(t)); err != nil {
+		t.Fatalf("Settle() = %v, want nil", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if want := []string{"run-0", "cleanup-0", "run-100"}; !slices.Equal(events, want) {
+		t.Errorf("events = %v, want %v (initial run, then one for 100 changes)", events, want)
+	}
+}
+
+// TestEffect_DebounceInitialAndStop verifies DebounceInitial delays the first run and Stop cancels a pending one
+func TestEffect_DebounceInitialAndStop(t *testing.T) {
+	count := New(0)
+	var runs atomic.Int32
+	eff := EffectWithOptions(func() func() {
+		runs.Add(1)
+		return nil
+	}, EffectOptions{Debounce: 20 * time.Millisecond, DebounceInitial: true}, count.AsReadonly())
+
+	if runs.Load() != 0 {
+		t.Error("initial run not debounced")
+	}
+	waitFor(t, func() bool { return runs.Load() == 1 })
+
+	count.Set(1)
+	eff.Stop()
+	time.Sleep(40 * time.Millisecond)
+	if n := runs.Load(); n != 1 {
+		t.Errorf("%d runs, want 1 (pending run canceled by Stop)", n)
+	}
+}
+
+// TestEffect_RunCount verifies RunCount counts the initial run and each dependency change, and LastRunTime advances
+func TestEffect_RunCount(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := NewFakeClock(start)
+	count := New(0)
+	eff := EffectWithOptions(func() func() {
+		count.Get()
+		return nil
+	}, EffectOptions{Clock: clock}, count.AsReadonly())
+	defer eff.Stop()
+
+	if got := eff.RunCount(); got != 1 {
+		t.Errorf("RunCount() = %d after creation, want 1", got)
+	}
+	if got := eff.LastRunTime(); !got.Equal(start) {
+		t.Errorf("LastRunTime() = %v, want %v", got, start)
+	}
+
+	for i := 1; i <= 3; i++ {
+		clock.Advance(time.Second)
+		count.Set(i)
+	}
+	if got := eff.RunCount(); got != 4 {
+		t.Errorf("RunCount() = %d after 3 changes, want 4", got)
+	}
+	if got, want := eff.LastRunTime(), start.Add(3*time.Second); !got.Equal(want) {
+		t.Errorf("LastRunTime() = %v, want %v", got, want)
+	}
+
+	lazy := EffectLazy(func() {}, count.AsReadonly())
+	defer lazy.Stop()
+	if got := lazy.RunCount(); got != 0 || !lazy.LastRunTime().IsZero() {
+		t.Errorf("lazy effect RunCount() = %d, LastRunTime() = %v; want 0 and zero", got, lazy.LastRunTime())
+	}
+}
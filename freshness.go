@@ -0,0 +1,88 @@
+package signals
+
+import (
+	"errors"
+	"fmt"
+	"runtime/debug"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// ErrRefreshFailed wraps the errors returned by Options.Refresh.
+var ErrRefreshFailed = errors.New("signals: refresh failed")
+
+// freshness bounds the age of a signal's value (Options.MaxAge).
+type freshness[T any] struct {
+	maxAge  time.Duration
+	refresh func() (T, error)
+	clock   Clock
+
+	// epoch anchors updated, which holds when the value was last stored or
+	// refreshed as monotonic nanoseconds since epoch
+	epoch   time.Time
+	updated atomic.Int64
+
+	// mu serializes refreshes, so concurrent Gets of a stale value wait
+	// for one refresh instead of each starting their own
+	mu sync.Mutex
+}
+
+// newFreshness returns a freshness for Options, or nil if MaxAge is unset.
+func newFreshness[T any](opts Options[T]) *freshness[T] {
+	if opts.MaxAge <= 0 || opts.Refresh == nil {
+		return nil
+	}
+	clock := clockOrReal(opts.Clock)
+	return &freshness[T]{maxAge: opts.MaxAge, refresh: opts.Refresh, clock: clock, epoch: clock.Now()}
+}
+
+// touch marks the value as fetched now.
+func (f *freshness[T]) touch() {
+	f.updated.Store(int64(f.clock.Now().Sub(f.epoch)))
+}
+
+// stale reports whether the value is older than maxAge.
+func (f *freshness[T]) stale() bool {
+	return f.clock.Now().Sub(f.epoch)-time.Duration(f.updated.Load()) > f.maxAge
+}
+
+// refreshIfStale re-fetches a value older than MaxAge and sets it. On
+// failure the stale value is kept for another MaxAge, so a failing source
+// is not called on every Get.
+func (s *signal[T]) refreshIfStale() {
+	f := s.freshness
+	if !f.stale() {
+		return
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if !f.stale() {
+		return // Refreshed by a concurrent Get
+	}
+
+	v, ok := s.fetch()
+	f.touch() // Before Set, so subscribers reading the signal don't refresh again
+	if ok {
+		s.Set(v)
+	}
+}
+
+// fetch calls Options.Refresh, reporting its error or panic.
+func (s *signal[T]) fetch() (v T, ok bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			if s.onPanic != nil {
+				s.onPanic(r, debug.Stack())
+			} else {
+				logPanic(s.logger, "refresh", r, debug.Stack())
+			}
+		}
+	}()
+	v, err := s.freshness.refresh()
+	if err != nil {
+		s.reportError(fmt.Errorf("%w: %w", ErrRefreshFailed, err))
+		return v, false
+	}
+	return v, true
+}
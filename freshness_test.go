@@ -0,0 +1,92 @@
+package signals
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// TestMaxAge_RefreshesStaleValue verifies Get serves the cached value within MaxAge and refreshes after it
+func TestMaxAge_RefreshesStaleValue(t *testing.T) {
+	var fetches atomic.Int32
+	sig := NewWithOptions(0, Options[int]{
+		MaxAge:  30 * time.Millisecond,
+		Refresh: func() (int, error) { return int(fetches.Add(1)) * 10, nil },
+	})
+	var notified []int
+	sig.SubscribeForever(func(v int) { notified = append(notified, v) })
+
+	if v := sig.Get(); v != 0 || fetches.Load() != 0 {
+		t.Errorf("Get() = %d after %d fetches, want the initial value without fetching", v, fetches.Load())
+	}
+
+	time.Sleep(50 * time.Millisecond)
+	if v := sig.Get(); v != 10 {
+		t.Errorf("Get() after MaxAge = %d, want 10", v)
+	}
+	if v := sig.Get(); v != 10 || fetches.Load() != 1 {
+		t.Errorf("Get() = %d after %d fetches, want the cached 10 after 1", v, fetches.Load())
+	}
+	if len(notified) != 1 || notified[0] != 10 {
+		t.Errorf("subscribers got %v, want [10]", notified)
+	}
+
+	time.Sleep(50 * time.Millisecond)
+	sig.Set(5) // A write makes the value fresh again
+	if v := sig.Get(); v != 5 || fetches.Load() != 1 {
+		t.Errorf("Get() after Set = %d after %d fetches, want 5 without fetching", v, fetches.Load())
+	}
+}
+
+// TestMaxAge_CoalescesRefreshes verifies concurrent Gets of a stale value share one refresh
+func TestMaxAge_CoalescesRefreshes(t *testing.T) {
+	var fetches atomic.Int32
+	sig := NewWithOptions("", Options[string]{
+		MaxAge: 10 * time.Millisecond,
+		Refresh: func() (string, error) {
+			fetches.Add(1)
+			time.Sleep(20 * time.Millisecond)
+			return "fresh", nil
+		},
+	})
+	time.Sleep(20 * time.Millisecond)
+
+	var wg sync.WaitGroup
+	for range 20 {
+		wg.Go(func() {
+			if v := sig.Get(); v != "fresh" {
+				t.Errorf("Get() = %q, want fresh", v)
+			}
+		})
+	}
+	wg.Wait()
+
+	if n := fetches.Load(); n != 1 {
+		t.Errorf("%d refreshes, want 1", n)
+	}
+}
+
+// TestMaxAge_RefreshError verifies a failed refresh is reported and the stale value served
+func TestMaxAge_RefreshError(t *testing.T) {
+	var reported []error
+	var fetches int
+	sig := NewWithOptions(1, Options[int]{
+		MaxAge: 10 * time.Millisecond,
+		Refresh: func() (int, error) {
+			fetches++
+			return 0, errors.New("unavailable")
+		},
+		OnPanic: func(r any, _ []byte) { reported = append(reported, r.(error)) },
+	})
+	time.Sleep(20 * time.Millisecond)
+
+	if v := sig.Get(); v != 1 {
+		t.Errorf("Get() = %d, want the stale 1", v)
+	}
+	sig.Get() // Backs off for another MaxAge
+	if fetches != 1 || len(reported) != 1 || !errors.Is(reported[0], ErrRefreshFailed) {
+		t.Errorf("%d fetches, reported %v, want 1 fetch and one ErrRefreshFailed", fetches, reported)
+	}
+}
@@ -0,0 +1,65 @@
+package signals
+
+import "time"
+
+// DefaultHistoryLimit is the number of values a signal records with
+// Options.RecordHistory when Options.HistoryLimit is zero.
+const DefaultHistoryLimit = 1000
+
+// Record is a value a signal held, and when it was stored.
+type Record[T any] struct {
+	Time  time.Time
+	Value T
+}
+
+// history is a bounded log of stored values, dropping the oldest when full.
+// Protected by the signal's mu.
+type history[T any] struct {
+	records []Record[T]
+
+	// next is the index the next record goes to once records is full
+	next int
+	// limit is the maximum length of records
+	limit int
+}
+
+// newHistory returns a history for Options, or nil if recording is off.
+func newHistory[T any](opts Options[T]) *history[T] {
+	if !opts.RecordHistory {
+		return nil
+	}
+	limit := opts.HistoryLimit
+	if limit <= 0 {
+		limit = DefaultHistoryLimit
+	}
+	return &history[T]{limit: limit}
+}
+
+// add records v as stored now.
+func (h *history[T]) add(v T) {
+	r := Record[T]{Time: time.Now(), Value: v}
+	if len(h.records) < h.limit {
+		h.records = append(h.records, r)
+		return
+	}
+	h.records[h.next] = r
+	h.next = (h.next + 1) % h.limit
+}
+
+// snapshot copies the records, oldest first.
+func (h *history[T]) snapshot() []Record[T] {
+	out := make([]Record[T], 0, len(h.records))
+	out = append(out, h.records[h.next:]...)
+	return append(out, h.records[:h.next]...)
+}
+
+// History returns the values recorded with Options.RecordHistory, oldest
+// first, or nil if recording is off.
+func (s *signal[T]) History() []Record[T] {
+	if s.history == nil {
+		return nil
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.history.snapshot()
+}
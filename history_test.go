@@ -0,0 +1,60 @@
+package signals
+
+import "testing"
+
+// TestHistory_RecordsValuesInOrder verifies every stored value is recorded with increasing timestamps
+func TestHistory_RecordsValuesInOrder(t *testing.T) {
+	sig := NewWithOptions(0, Options[int]{RecordHistory: true})
+	sig.Set(1)
+	sig.Update(func(v int) int { return v + 1 })
+	sig.Set(3)
+
+	h := sig.(HistoryReader[int]).History()
+	if len(h) != 4 {
+		t.Fatalf("len(History()) = %d, want 4", len(h))
+	}
+	for i, r := range h {
+		if r.Value != i {
+			t.Errorf("History()[%d].Value = %d, want %d", i, r.Value, i)
+		}
+		if i > 0 && r.Time.Before(h[i-1].Time) {
+			t.Errorf("History()[%d] at %v, before the previous record at %v", i, r.Time, h[i-1].Time)
+		}
+	}
+}
+
+// TestHistory_Limit verifies the oldest records are dropped past HistoryLimit
+func TestHistory_Limit(t *testing.T) {
+	sig := NewWithOptions(0, Options[int]{RecordHistory: true, HistoryLimit: 3})
+	for i := 1; i <= 5; i++ {
+		sig.Set(i)
+	}
+
+	h := sig.(HistoryReader[int]).History()
+	if len(h) != 3 {
+		t.Fatalf("len(History()) = %d, want 3", len(h))
+	}
+	for i, want := range []int{3, 4, 5} {
+		if h[i].Value != want {
+			t.Errorf("History()[%d].Value = %d, want %d", i, h[i].Value, want)
+		}
+	}
+}
+
+// TestHistory_Disabled verifies nothing is recorded by default, and skipped writes aren't recorded
+func TestHistory_Disabled(t *testing.T) {
+	sig := New(0)
+	sig.Set(1)
+	if h := sig.(HistoryReader[int]).History(); h != nil {
+		t.Errorf("History() = %v without RecordHistory, want nil", h)
+	}
+
+	cmp := NewWithOptions(0, Options[int]{
+		RecordHistory: true,
+		Equal:         func(a, b int) bool { return a == b },
+	})
+	cmp.Set(0) // Equal: nothing stored
+	if h := cmp.(HistoryReader[int]).History(); len(h) != 1 {
+		t.Errorf("len(History()) = %d after an equal Set, want 1", len(h))
+	}
+}
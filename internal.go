@@ -1,6 +1,139 @@
 package signals
 
-import "reflect"
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"reflect"
+	"runtime"
+	"strconv"
+)
+
+// ErrNilDependency is reported when a nil dependency is passed to Computed
+// or Effect (typically a signal field not initialized yet). The dependency
+// is skipped: nothing is subscribed for it.
+var ErrNilDependency = errors.New("signals: nil dependency ignored")
+
+// dependencySource is implemented by the package's own signal types.
+// It lets Computed and Effect subscribe with a recorded DependentKind,
+// so sources can report what depends on them (see Dependents).
+type dependencySource interface {
+	subscribeDependent(kind DependentKind, r reaction) Unsubscribe
+}
+
+// versionSource is implemented by signal types that count their changes.
+// A computed records its dependencies' versions when it recomputes, and
+// skips change notifications that arrive after it has already seen them.
+type versionSource interface {
+	version() uint64
+}
+
+// versionSources returns the version counters of deps in order, or nil
+// unless every dependency has one (a change can't be ruled out otherwise).
+// AsReadonly views resolve to their source.
+func versionSources(deps []any) []versionSource {
+	if len(deps) == 0 {
+		return nil
+	}
+	sources := make([]versionSource, len(deps))
+	for i, dep := range deps {
+		src, ok := dependencyKey(dep).(versionSource)
+		if !ok {
+			return nil
+		}
+		sources[i] = src
+	}
+	return sources
+}
+
+// nonNilDependencies returns deps without nil entries (untyped nil, or a
+// nil pointer, map, func, or channel in an interface), reporting each
+// skipped one. deps is returned as is when it has none.
+func nonNilDependencies(deps []any, report func(error)) []any {
+	var kept []any // Allocated at the first nil dependency
+	for i, dep := range deps {
+		if !isNilDependency(dep) {
+			if kept != nil {
+				kept = append(kept, dep)
+			}
+			continue
+		}
+		if kept == nil {
+			kept = append(make([]any, 0, len(deps)), deps[:i]...)
+		}
+		report(fmt.Errorf("%w (dependency %d)", ErrNilDependency, i))
+	}
+	if kept == nil {
+		return deps
+	}
+	return kept
+}
+
+// isNilDependency reports whether dep is nil or a typed nil.
+func isNilDependency(dep any) bool {
+	if dep == nil {
+		return true
+	}
+	switch v := reflect.ValueOf(dep); v.Kind() {
+	case reflect.Pointer, reflect.Map, reflect.Func, reflect.Chan, reflect.Interface, reflect.Slice:
+		return v.IsNil()
+	default:
+		return false
+	}
+}
+
+// trackDependentHelper subscribes a computed or effect to a dependency.
+// Package signal types record the dependent's kind; anything else falls
+// back to trackDependencyHelper, firing r on every change.
+func trackDependentHelper(dep any, kind DependentKind, r reaction) Unsubscribe {
+	if src, ok := dep.(dependencySource); ok {
+		return src.subscribeDependent(kind, r)
+	}
+	return trackDependencyHelper(dep, r.fire)
+}
+
+// dependencyKey returns the identity of a dependency, so that a signal and
+// its AsReadonly views (new wrappers on every call) compare as the same.
+func dependencyKey(dep any) any {
+	if view, ok := dep.(interface{ dependencyKey() any }); ok {
+		return view.dependencyKey()
+	}
+	return dep
+}
+
+// dependencyView is embedded by wrappers that stand for source as a
+// dependency: computeds and effects depending on the wrapper register with
+// source as its own dependents (with an untracked subscription if source
+// is a foreign implementation), and the wrapper shares its dependencyKey.
+type dependencyView[T any] struct {
+	source ReadonlySignal[T]
+}
+
+// subscribeDependent registers dependent with source.
+func (v dependencyView[T]) subscribeDependent(kind DependentKind, dependent reaction) Unsubscribe {
+	if src, ok := v.source.(dependencySource); ok {
+		return src.subscribeDependent(kind, dependent)
+	}
+	return v.source.SubscribeForever(func(T) { dependent.fire() })
+}
+
+// dependencyKey returns the identity of source.
+func (v dependencyView[T]) dependencyKey() any {
+	return dependencyKey(v.source)
+}
+
+// sameDependency reports whether two dependency keys are identical.
+// Non-comparable keys are never the same, instead of panicking.
+func sameDependency(a, b any) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	ta := reflect.TypeOf(a)
+	if ta != reflect.TypeOf(b) || !ta.Comparable() {
+		return false
+	}
+	return a == b
+}
 
 // trackDependencyHelper is a shared helper for subscribing to dependencies with type erasure.
 // It handles the complexity of subscribing to ReadonlySignal[X] where X is unknown at compile time.
@@ -81,3 +214,23 @@ func subscribeAnyType(dep any, onChange func()) Unsubscribe {
 
 	return func() {}
 }
+
+// goroutineID returns the runtime ID of the calling goroutine.
+//
+// Go deliberately doesn't expose goroutine identity, so this parses the
+// header of runtime.Stack ("goroutine 123 [running]:"). It is only used
+// to detect same-goroutine reentrancy, never for scheduling decisions.
+func goroutineID() uint64 {
+	var buf [64]byte
+	n := runtime.Stack(buf[:], false)
+	b := bytes.TrimPrefix(buf[:n], []byte("goroutine "))
+	end := bytes.IndexByte(b, ' ')
+	if end < 0 {
+		return 0
+	}
+	id, err := strconv.ParseUint(string(b[:end]), 10, 64)
+	if err != nil {
+		return 0
+	}
+	return id
+}
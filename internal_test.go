@@ -1,6 +1,7 @@
 package signals
 
 import (
+	"errors"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -226,3 +227,74 @@ func TestTrackDependency_AnyTypeSignal(t *testing.T) {
 		t.Errorf("After Set, comp.Get() = %q, want %q", got, "world")
 	}
 }
+
+// TestGoroutineID verifies goroutine IDs are non-zero and distinct across goroutines.
+func TestGoroutineID(t *testing.T) {
+	main := goroutineID()
+	if main == 0 {
+		t.Fatal("goroutineID() returned 0")
+	}
+	if again := goroutineID(); again != main {
+		t.Errorf("goroutineID() not stable: %d then %d", main, again)
+	}
+
+	other := make(chan uint64)
+	go func() { other <- goroutineID() }()
+	if id := <-other; id == main || id == 0 {
+		t.Errorf("goroutineID() in another goroutine = %d, main = %d", id, main)
+	}
+}
+
+// TestNilDependency_Computed verifies Computed skips and reports nil dependencies
+func TestNilDependency_Computed(t *testing.T) {
+	src := New(1)
+	var missing ReadonlySignal[int] // Not initialized yet
+	var reported []any
+
+	comp := ComputedWithOptions(func() int { return src.Get() * 2 }, Options[int]{
+		OnPanic: func(err any, _ []byte) { reported = append(reported, err) },
+	}, missing, src.AsReadonly(), (*readonlySignal[int])(nil))
+
+	if len(reported) != 2 {
+		t.Fatalf("reported %v, want 2 nil dependency errors", reported)
+	}
+	for _, r := range reported {
+		if err, ok := r.(error); !ok || !errors.Is(err, ErrNilDependency) {
+			t.Errorf("reported %v, want ErrNilDependency", r)
+		}
+	}
+
+	src.Set(2)
+	if got := comp.Get(); got != 4 {
+		t.Errorf("Get() = %d, want 4", got)
+	}
+}
+
+// TestNilDependency_Effect verifies Effect skips and reports nil dependencies
+func TestNilDependency_Effect(t *testing.T) {
+	src := New(1)
+	var missing ReadonlySignal[int]
+	var runs, reports int32
+
+	eff := EffectWithOptions(func() func() {
+		atomic.AddInt32(&runs, 1)
+		return nil
+	}, EffectOptions{
+		OnPanic: func(err any, _ []byte) {
+			if e, ok := err.(error); ok && errors.Is(e, ErrNilDependency) {
+				atomic.AddInt32(&reports, 1)
+			}
+		},
+	}, missing, src.AsReadonly())
+	defer eff.Stop()
+
+	eff.AddDependency(nil)
+	src.Set(2)
+
+	if got := atomic.LoadInt32(&reports); got != 2 {
+		t.Errorf("nil dependency reports = %d, want 2", got)
+	}
+	if got := atomic.LoadInt32(&runs); got != 2 {
+		t.Errorf("runs = %d, want 2", got)
+	}
+}
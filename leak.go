@@ -0,0 +1,104 @@
+package signals
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+	"sync/atomic"
+)
+
+// LeakError describes a subscription whose Unsubscribe was garbage collected
+// without ever being called. See SetLeakHandler.
+type LeakError struct {
+	// Function, File, and Line locate the Subscribe call that leaked
+	Function string
+	File     string
+	Line     int
+}
+
+// Error describes the leaked subscription and where it was created.
+func (e *LeakError) Error() string {
+	return fmt.Sprintf("signals: Unsubscribe never called for subscription created at %s:%d (%s)",
+		e.File, e.Line, e.Function)
+}
+
+// leakHandler is the active leak handler, nil when detection is off.
+var leakHandler atomic.Pointer[func(*LeakError)]
+
+// SetLeakHandler enables leak detection for subscriptions: every Unsubscribe
+// returned while a handler is set is tracked, and if it is garbage collected
+// without having been called, fn receives the site of the Subscribe call.
+// Subscriptions that ended otherwise, when their context was done or the
+// signal was closed, are not reported. Pass nil to disable detection again.
+//
+// Detection is off by default and then costs a single atomic load per
+// Subscribe. It is meant for development and tests; reports arrive on the
+// garbage collector's schedule, from a runtime goroutine, so a panicking fn
+// crashes the process.
+//
+// Example:
+//
+//	func TestMain(m *testing.M) {
+//	    signals.SetLeakHandler(func(err *signals.LeakError) { panic(err) })
+//	    os.Exit(m.Run())
+//	}
+func SetLeakHandler(fn func(*LeakError)) {
+	if fn == nil {
+		leakHandler.Store(nil)
+		return
+	}
+	leakHandler.Store(&fn)
+}
+
+// leakToken is kept alive by a tracked Unsubscribe; its collection means
+// the Unsubscribe is unreachable.
+type leakToken struct {
+	_ [16]byte // Avoid tiny-allocator batching with the cleanup's state
+}
+
+// leakState is shared by a tracked Unsubscribe and its cleanup.
+type leakState struct {
+	called atomic.Bool
+	site   *LeakError
+}
+
+// trackLeak wraps unsub for leak detection if a leak handler is set. active
+// reports whether the subscription is still registered; one that is not
+// when the Unsubscribe is collected ended without it and is not a leak.
+func trackLeak(unsub Unsubscribe, active func() bool) Unsubscribe {
+	handler := leakHandler.Load()
+	if handler == nil {
+		return unsub
+	}
+
+	state := &leakState{site: subscribeSite()}
+	token := &leakToken{}
+	report := *handler
+	// The cleanup must not reference token, otherwise it is never unreachable
+	runtime.AddCleanup(token, func(state *leakState) {
+		if !state.called.Load() && active() {
+			report(state.site)
+		}
+	}, state)
+
+	return func() {
+		state.called.Store(true)
+		runtime.KeepAlive(token)
+		unsub()
+	}
+}
+
+// subscribeSite locates the first caller outside this package
+// (test files of this package count as callers).
+func subscribeSite() *LeakError {
+	pcs := make([]uintptr, 32)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(2, pcs)])
+	for {
+		frame, more := frames.Next()
+		internal := strings.HasPrefix(frame.Function, "github.com/coregx/signals.") &&
+			!strings.HasSuffix(frame.File, "_test.go")
+		if !internal || !more {
+			return &LeakError{Function: frame.Function, File: frame.File, Line: frame.Line}
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package signals
+
+import (
+	"context"
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestSetLeakHandler_ReportsDroppedUnsubscribe verifies a collected, never-called
+// Unsubscribe is reported with its subscription site
+func TestSetLeakHandler_ReportsDroppedUnsubscribe(t *testing.T) {
+	leaks := make(chan *LeakError, 1)
+	SetLeakHandler(func(err *LeakError) {
+		select {
+		case leaks <- err:
+		default:
+		}
+	})
+	defer SetLeakHandler(nil)
+
+	sig := New(0)
+	func() {
+		_ = sig.SubscribeForever(func(int) {}) // Dropped without calling
+	}()
+
+	deadline := time.After(2 * time.Second)
+	for {
+		runtime.GC()
+		select {
+		case err := <-leaks:
+			if !strings.HasSuffix(err.File, "leak_test.go") || err.Line == 0 {
+				t.Errorf("leak site = %s:%d, want a line in leak_test.go", err.File, err.Line)
+			}
+			if !strings.Contains(err.Error(), "leak_test.go") {
+				t.Errorf("Error() = %q, want the subscription site", err.Error())
+			}
+			return
+		case <-deadline:
+			t.Fatal("leaked subscription was not reported")
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
+
+// TestSetLeakHandler_CalledUnsubscribeNotReported verifies proper cleanup is not a leak
+func TestSetLeakHandler_CalledUnsubscribeNotReported(t *testing.T) {
+	leaks := make(chan *LeakError, 1)
+	SetLeakHandler(func(err *LeakError) {
+		select {
+		case leaks <- err:
+		default:
+		}
+	})
+	defer SetLeakHandler(nil)
+
+	comp := Computed(func() int { return 1 })
+	func() {
+		unsub := comp.SubscribeForever(func(int) {})
+		unsub()
+	}()
+
+	for i := 0; i < 5; i++ {
+		runtime.GC()
+		time.Sleep(10 * time.Millisecond)
+	}
+	select {
+	case err := <-leaks:
+		t.Errorf("unexpected leak report: %v", err)
+	default:
+	}
+}
+
+// TestSetLeakHandler_OffByDefault verifies Unsubscribe is returned unwrapped when disabled
+func TestSetLeakHandler_OffByDefault(t *testing.T) {
+	if leakHandler.Load() != nil {
+		t.Fatal("leak detection enabled by default")
+	}
+
+	unsub := Unsubscribe(func() {})
+	if got := trackLeak(unsub, nil); reflect.ValueOf(got).Pointer() != reflect.ValueOf(unsub).Pointer() {
+		t.Error("trackLeak wrapped Unsubscribe while detection is off")
+	}
+}
+
+// TestSetLeakHandler_EndedSubscriptionNotReported verifies subscriptions ended by their context or by Close are not leaks
+func TestSetLeakHandler_EndedSubscriptionNotReported(t *testing.T) {
+	leaks := make(chan *LeakError, 1)
+	SetLeakHandler(func(err *LeakError) {
+		select {
+		case leaks <- err:
+		default:
+		}
+	})
+	defer SetLeakHandler(nil)
+
+	sig := New(0)
+	comp := Computed(func() int { return sig.Get() }, sig)
+	defer comp.Cleanup()
+	closed := New(0)
+	func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		_ = sig.Subscribe(ctx, func(int) {}) // Auto-cleanup idiom
+		_ = comp.Subscribe(ctx, func(int) {})
+		cancel()
+		_ = closed.SubscribeForever(func(int) {})
+		closed.Close()
+	}()
+	waitFor(t, func() bool { return subscriberCount(sig) == 0 })
+	waitFor(t, func() bool {
+		c := comp.(*computed[int])
+		c.mu.RLock()
+		defer c.mu.RUnlock()
+		return len(c.subscribers) == 0
+	})
+
+	for range 5 {
+		runtime.GC()
+		time.Sleep(10 * time.Millisecond)
+	}
+	select {
+	case err := <-leaks:
+		t.Errorf("unexpected leak report: %v", err)
+	default:
+	}
+}
@@ -0,0 +1,198 @@
+package signals
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+)
+
+// ListChangeKind describes the kind of mutation applied to a List.
+type ListChangeKind int
+
+const (
+	// ListAppend indicates a value was appended at Index.
+	ListAppend ListChangeKind = iota
+
+	// ListRemove indicates the value at Index was removed.
+	ListRemove
+
+	// ListSet indicates the value at Index was replaced.
+	ListSet
+)
+
+// String returns a human-readable name for the change kind.
+func (k ListChangeKind) String() string {
+	switch k {
+	case ListAppend:
+		return "append"
+	case ListRemove:
+		return "remove"
+	case ListSet:
+		return "set"
+	default:
+		return fmt.Sprintf("ListChangeKind(%d)", int(k))
+	}
+}
+
+// ListChange describes a single element-level mutation of a List.
+//
+// Fields that don't apply to a kind hold the zero value:
+//   - ListAppend: New is the appended value, Old is zero
+//   - ListRemove: Old is the removed value, New is zero
+//   - ListSet: Old and New are the previous and replacement values
+type ListChange[T any] struct {
+	Kind  ListChangeKind
+	Index int
+	Old   T
+	New   T
+}
+
+// List is a reactive slice that notifies subscribers with element-level
+// change descriptors instead of the whole slice.
+//
+// This enables efficient incremental updates (e.g., UI lists) without
+// diffing the full slice on every write.
+//
+// Example:
+//
+//	items := signals.NewList[string]()
+//	unsub := items.SubscribeForever(func(c signals.ListChange[string]) {
+//	    fmt.Printf("%s at %d: %q -> %q\n", c.Kind, c.Index, c.Old, c.New)
+//	})
+//	defer unsub()
+//
+//	items.Append("a")     // append at 0: "" -> "a"
+//	items.SetAt(0, "b")   // set at 0: "a" -> "b"
+//	items.RemoveAt(0)     // remove at 0: "b" -> ""
+type List[T any] interface {
+	// Get returns a copy of the current elements.
+	Get() []T
+
+	// Len returns the number of elements.
+	Len() int
+
+	// At returns the element at index i. Panics if i is out of range.
+	At(i int) T
+
+	// Append adds a value to the end of the list.
+	Append(value T)
+
+	// RemoveAt removes the element at index i. Panics if i is out of range.
+	RemoveAt(i int)
+
+	// SetAt replaces the element at index i. Panics if i is out of range.
+	SetAt(i int, value T)
+
+	// Subscribe registers a callback that receives each change descriptor.
+	Subscribe(ctx context.Context, fn func(ListChange[T])) Unsubscribe
+
+	// SubscribeForever registers a callback that will never be automatically canceled.
+	SubscribeForever(fn func(ListChange[T])) Unsubscribe
+}
+
+// list is the internal implementation of List[T].
+// The backing slice is guarded by mu; change notifications are delivered
+// through an internal signal so they share its panic recovery.
+type list[T any] struct {
+	// items is the backing slice
+	items []T
+
+	// mu protects items. Writers take it inside the changes signal's write
+	// lock, see commit
+	mu sync.RWMutex
+
+	// changes broadcasts change descriptors to subscribers
+	changes *signal[ListChange[T]]
+}
+
+// NewList creates an empty reactive list.
+func NewList[T any]() List[T] {
+	return NewListWithOptions[T](Options[ListChange[T]]{})
+}
+
+// NewListWithOptions creates an empty reactive list with custom options
+// applied to change notifications (e.g., OnPanic).
+func NewListWithOptions[T any](opts Options[ListChange[T]]) List[T] {
+	return &list[T]{changes: newSignal(ListChange[T]{}, opts)}
+}
+
+// Get returns a copy of the current elements.
+func (l *list[T]) Get() []T {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return slices.Clone(l.items)
+}
+
+// Len returns the number of elements.
+func (l *list[T]) Len() int {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return len(l.items)
+}
+
+// At returns the element at index i.
+func (l *list[T]) At(i int) T {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	l.checkIndex(i)
+	return l.items[i]
+}
+
+// Append adds a value to the end of the list and emits a ListAppend change.
+func (l *list[T]) Append(value T) {
+	l.commit(func() ListChange[T] {
+		index := len(l.items)
+		l.items = append(l.items, value)
+		return ListChange[T]{Kind: ListAppend, Index: index, New: value}
+	})
+}
+
+// RemoveAt removes the element at index i and emits a ListRemove change.
+func (l *list[T]) RemoveAt(i int) {
+	l.commit(func() ListChange[T] {
+		l.checkIndex(i)
+		old := l.items[i]
+		l.items = slices.Delete(l.items, i, i+1)
+		return ListChange[T]{Kind: ListRemove, Index: i, Old: old}
+	})
+}
+
+// SetAt replaces the element at index i and emits a ListSet change.
+func (l *list[T]) SetAt(i int, value T) {
+	l.commit(func() ListChange[T] {
+		l.checkIndex(i)
+		old := l.items[i]
+		l.items[i] = value
+		return ListChange[T]{Kind: ListSet, Index: i, Old: old, New: value}
+	})
+}
+
+// commit runs mutate under mu and emits the change it returns. Both happen
+// under the changes signal's write lock, so concurrent mutations are
+// delivered in the order they were applied.
+func (l *list[T]) commit(mutate func() ListChange[T]) {
+	_ = l.changes.apply(func(ListChange[T]) (ListChange[T], bool) {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		return mutate(), true
+	}, nil)
+}
+
+// Subscribe registers a callback that receives each change descriptor.
+func (l *list[T]) Subscribe(ctx context.Context, fn func(ListChange[T])) Unsubscribe {
+	return l.changes.Subscribe(ctx, fn)
+}
+
+// SubscribeForever registers a callback that never auto-cancels.
+func (l *list[T]) SubscribeForever(fn func(ListChange[T])) Unsubscribe {
+	return l.changes.SubscribeForever(fn)
+}
+
+// checkIndex panics if i is out of range.
+// Caller must hold mu and release it with defer.
+func (l *list[T]) checkIndex(i int) {
+	if i < 0 || i >= len(l.items) {
+		panic(fmt.Sprintf("signals: list index %d out of range [0:%d]", i, len(l.items)))
+	}
+}
@@ -0,0 +1,159 @@
+package signals
+
+import (
+	"slices"
+	"sync"
+	"testing"
+)
+
+// TestList_Append verifies Append emits an append descriptor with the new index
+func TestList_Append(t *testing.T) {
+	l := NewList[string]()
+
+	var changes []ListChange[string]
+	var mu sync.Mutex
+	unsub := l.SubscribeForever(func(c ListChange[string]) {
+		mu.Lock()
+		changes = append(changes, c)
+		mu.Unlock()
+	})
+	defer unsub()
+
+	l.Append("a")
+	l.Append("b")
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	want := []ListChange[string]{
+		{Kind: ListAppend, Index: 0, New: "a"},
+		{Kind: ListAppend, Index: 1, New: "b"},
+	}
+	if !slices.Equal(changes, want) {
+		t.Errorf("changes = %+v, want %+v", changes, want)
+	}
+	if got := l.Get(); !slices.Equal(got, []string{"a", "b"}) {
+		t.Errorf("Get() = %v, want [a b]", got)
+	}
+}
+
+// TestList_RemoveAt verifies RemoveAt emits the removed value and shifts elements
+func TestList_RemoveAt(t *testing.T) {
+	l := NewList[int]()
+	l.Append(1)
+	l.Append(2)
+	l.Append(3)
+
+	var got ListChange[int]
+	unsub := l.SubscribeForever(func(c ListChange[int]) {
+		got = c
+	})
+	defer unsub()
+
+	l.RemoveAt(1)
+
+	want := ListChange[int]{Kind: ListRemove, Index: 1, Old: 2}
+	if got != want {
+		t.Errorf("change = %+v, want %+v", got, want)
+	}
+	if items := l.Get(); !slices.Equal(items, []int{1, 3}) {
+		t.Errorf("Get() = %v, want [1 3]", items)
+	}
+	if l.Len() != 2 {
+		t.Errorf("Len() = %d, want 2", l.Len())
+	}
+}
+
+// TestList_SetAt verifies SetAt emits both old and new values
+func TestList_SetAt(t *testing.T) {
+	l := NewList[int]()
+	l.Append(10)
+	l.Append(20)
+
+	var got ListChange[int]
+	unsub := l.SubscribeForever(func(c ListChange[int]) {
+		got = c
+	})
+	defer unsub()
+
+	l.SetAt(1, 25)
+
+	want := ListChange[int]{Kind: ListSet, Index: 1, Old: 20, New: 25}
+	if got != want {
+		t.Errorf("change = %+v, want %+v", got, want)
+	}
+	if v := l.At(1); v != 25 {
+		t.Errorf("At(1) = %d, want 25", v)
+	}
+}
+
+// TestList_GetReturnsCopy verifies callers cannot mutate the backing slice
+func TestList_GetReturnsCopy(t *testing.T) {
+	l := NewList[int]()
+	l.Append(1)
+
+	items := l.Get()
+	items[0] = 99
+
+	if v := l.At(0); v != 1 {
+		t.Errorf("At(0) = %d after mutating Get() result, want 1", v)
+	}
+}
+
+// TestList_IndexOutOfRange verifies out-of-range access panics without holding the lock
+func TestList_IndexOutOfRange(t *testing.T) {
+	l := NewList[int]()
+
+	func() {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("RemoveAt(0) on empty list did not panic")
+			}
+		}()
+		l.RemoveAt(0)
+	}()
+
+	// Lock must have been released by the panicking call
+	l.Append(1)
+	if l.Len() != 1 {
+		t.Errorf("Len() = %d, want 1", l.Len())
+	}
+}
+
+// TestList_ConcurrentChangesReplay verifies replaying the descriptors of concurrent writers rebuilds the list's final state
+func TestList_ConcurrentChangesReplay(t *testing.T) {
+	l := NewList[int]()
+	var replica []int
+	l.SubscribeForever(func(c ListChange[int]) { // Delivery is serialized
+		switch c.Kind {
+		case ListAppend:
+			if c.Index != len(replica) {
+				t.Errorf("append at %d onto a replica of length %d", c.Index, len(replica))
+			}
+			replica = append(replica, c.New)
+		case ListSet:
+			replica[c.Index] = c.New
+		case ListRemove:
+			replica = slices.Delete(replica, c.Index, c.Index+1)
+		}
+	})
+	l.Append(0)
+
+	var wg sync.WaitGroup
+	for w := range 8 {
+		wg.Go(func() {
+			for i := range 1000 {
+				if i%2 == 0 {
+					l.Append(w*1000 + i)
+				} else {
+					l.SetAt(0, w*1000+i)
+				}
+			}
+		})
+	}
+	wg.Wait()
+
+	if got := l.Get(); !slices.Equal(replica, got) {
+		t.Errorf("replica diverged from Get():\nreplica %v\nGet()   %v", replica, got)
+	}
+}
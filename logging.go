@@ -0,0 +1,31 @@
+package signals
+
+import (
+	"context"
+	"log"
+	"log/slog"
+)
+
+// logPanic reports a recovered panic that has no OnPanic handler.
+// It goes to logger at error level, or to the standard log package if
+// logger is nil. what describes where the panic happened.
+func logPanic(logger *slog.Logger, what string, r any, stack []byte) {
+	if logger == nil {
+		log.Printf("signals: panic in %s: %v\n%s", what, r, stack)
+		return
+	}
+	logger.LogAttrs(context.Background(), slog.LevelError, "signals: panic in "+what,
+		slog.Any("panic", r),
+		slog.String("stack", string(stack)),
+	)
+}
+
+// logError reports a non-panic failure that has no OnPanic handler,
+// to logger at error level or to the standard log package if nil.
+func logError(logger *slog.Logger, err error) {
+	if logger == nil {
+		log.Printf("%v", err)
+		return
+	}
+	logger.LogAttrs(context.Background(), slog.LevelError, err.Error(), slog.Any("error", err))
+}
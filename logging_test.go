@@ -0,0 +1,135 @@
+package signals
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// captureHandler is a slog.Handler that records every log record.
+type captureHandler struct {
+	mu      sync.Mutex
+	records []slog.Record
+}
+
+func (h *captureHandler) Enabled(context.Context, slog.Level) bool { return true }
+
+func (h *captureHandler) Handle(_ context.Context, r slog.Record) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.records = append(h.records, r.Clone())
+	return nil
+}
+
+func (h *captureHandler) WithAttrs([]slog.Attr) slog.Handler { return h }
+
+func (h *captureHandler) WithGroup(string) slog.Handler { return h }
+
+// only returns the single captured record, failing the test otherwise.
+func (h *captureHandler) only(t *testing.T) slog.Record {
+	t.Helper()
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if len(h.records) != 1 {
+		t.Fatalf("captured %d records, want 1", len(h.records))
+	}
+	return h.records[0]
+}
+
+// attrs returns the attributes of a record by key.
+func attrs(r slog.Record) map[string]slog.Value {
+	m := make(map[string]slog.Value)
+	r.Attrs(func(a slog.Attr) bool {
+		m[a.Key] = a.Value
+		return true
+	})
+	return m
+}
+
+// assertPanicRecord checks a record describes a recovered panic.
+func assertPanicRecord(t *testing.T, r slog.Record, msg string, value any) {
+	t.Helper()
+	if r.Level != slog.LevelError {
+		t.Errorf("Level = %v, want ERROR", r.Level)
+	}
+	if r.Message != msg {
+		t.Errorf("Message = %q, want %q", r.Message, msg)
+	}
+	a := attrs(r)
+	if got := a["panic"].Any(); got != value {
+		t.Errorf("panic attr = %v, want %v", got, value)
+	}
+	if !strings.Contains(a["stack"].String(), "goroutine") {
+		t.Errorf("stack attr = %q, want a stack trace", a["stack"].String())
+	}
+}
+
+// TestLogger_SignalSubscriberPanic verifies subscriber panics are logged to Options.Logger
+func TestLogger_SignalSubscriberPanic(t *testing.T) {
+	h := &captureHandler{}
+	sig := NewWithOptions(0, Options[int]{Logger: slog.New(h)})
+	sig.SubscribeForever(func(int) { panic("boom") })
+
+	sig.Set(1)
+
+	assertPanicRecord(t, h.only(t), "signals: panic in subscriber", "boom")
+}
+
+// TestLogger_ComputedPanic verifies compute panics are logged to Options.Logger
+func TestLogger_ComputedPanic(t *testing.T) {
+	h := &captureHandler{}
+	comp := ComputedWithOptions(func() int { panic("bad compute") }, Options[int]{Logger: slog.New(h)})
+
+	comp.Get()
+
+	assertPanicRecord(t, h.only(t), "signals: panic in computed function", "bad compute")
+}
+
+// TestLogger_EffectPanic verifies effect panics are logged to EffectOptions.Logger
+func TestLogger_EffectPanic(t *testing.T) {
+	h := &captureHandler{}
+	eff := EffectWithOptions(func() func() {
+		panic("bad effect")
+	}, EffectOptions{Logger: slog.New(h)})
+	defer eff.Stop()
+
+	assertPanicRecord(t, h.only(t), "signals: panic in effect function", "bad effect")
+}
+
+// TestLogger_Error verifies internal errors are logged with an error attribute
+func TestLogger_Error(t *testing.T) {
+	h := &captureHandler{}
+	sig := New(0)
+	eff := EffectWithOptions(func() func() {
+		sig.Set(sig.Get() + 1)
+		return nil
+	}, EffectOptions{Logger: slog.New(h)}, sig.AsReadonly())
+	defer eff.Stop()
+
+	r := h.only(t)
+	if got, ok := attrs(r)["error"].Any().(error); !ok || got != ErrEffectReentrant {
+		t.Errorf("error attr = %v, want ErrEffectReentrant", attrs(r)["error"])
+	}
+}
+
+// TestLogger_OnPanicTakesPrecedence verifies OnPanic still receives panics when Logger is set
+func TestLogger_OnPanicTakesPrecedence(t *testing.T) {
+	h := &captureHandler{}
+	var handled any
+	sig := NewWithOptions(0, Options[int]{
+		Logger:  slog.New(h),
+		OnPanic: func(err any, _ []byte) { handled = err },
+	})
+	sig.SubscribeForever(func(int) { panic("boom") })
+
+	sig.Set(1)
+
+	if handled != "boom" {
+		t.Errorf("OnPanic received %v, want boom", handled)
+	}
+	if len(h.records) != 0 {
+		t.Errorf("captured %d records, want 0", len(h.records))
+	}
+}
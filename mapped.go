@@ -0,0 +1,238 @@
+package signals
+
+import (
+	"fmt"
+	"runtime/debug"
+)
+
+// mappedSignal is a two-way converted view of a source signal.
+// Reads and subscriptions are served by the embedded signal, which mirrors the source
+// through to; writes are converted with from and forwarded to the source.
+type mappedSignal[T, U any] struct {
+	// signal holds the converted value and this signal's own subscribers.
+	// Only written by sync, so its embedded write methods are overridden.
+	*signal[U]
+
+	source Signal[T]
+	to     func(T) U
+	from   func(U) T
+
+	// validator and onRejected guard writes before conversion
+	validator  func(U) error
+	onRejected func(U, error)
+
+	// unsubscribe stops mirroring the source
+	unsubscribe Unsubscribe
+}
+
+// MapTwoWay returns a writable signal that converts the values of s.
+//
+// Reading returns to(s.Get()), and subscribers are notified whenever s
+// changes. Writing converts the value with from and writes the result to s,
+// so both signals always agree. This is useful for value conversions such as
+// int <-> float64, or a numeric signal edited through a text field.
+//
+// Example:
+//
+//	celsius := signals.New(20.0)
+//	fahrenheit := signals.MapTwoWay(celsius,
+//	    func(c float64) float64 { return c*9/5 + 32 },
+//	    func(f float64) float64 { return (f - 32) * 5 / 9 },
+//	)
+//	fahrenheit.Set(212)
+//	fmt.Println(celsius.Get()) // 100
+func MapTwoWay[T, U any](s Signal[T], to func(T) U, from func(U) T) Signal[U] {
+	return MapTwoWayWithOptions(s, to, from, Options[U]{})
+}
+
+// MapTwoWayWithOptions is MapTwoWay with options for the converted signal.
+//
+// Validate rejects writes before they are converted, which is the preferred
+// way to handle values from cannot accept (e.g., unparsable strings): SetE
+// returns the error and OnRejected is called. If from panics instead, the
+// panic is reported to OnPanic, the write is dropped, and SetE returns a
+// *PanicError. Equal suppresses notifications of the converted signal when
+// the converted value did not change.
+//
+// Example:
+//
+//	port := signals.New(8080)
+//	text := signals.MapTwoWayWithOptions(port, strconv.Itoa,
+//	    func(s string) int { n, _ := strconv.Atoi(s); return n },
+//	    signals.Options[string]{
+//	        Validate: func(s string) error {
+//	            _, err := strconv.Atoi(s)
+//	            return err
+//	        },
+//	    },
+//	)
+//	err := text.SetE("http") // Rejected, port stays 8080
+func MapTwoWayWithOptions[T, U any](s Signal[T], to func(T) U, from func(U) T, opts Options[U]) Signal[U] {
+	m := &mappedSignal[T, U]{
+		signal:     newSignal(to(s.Get()), Options[U]{Equal: opts.Equal, OnPanic: opts.OnPanic, Logger: opts.Logger}),
+		source:     s,
+		to:         to,
+		from:       from,
+		validator:  opts.Validate,
+		onRejected: opts.OnRejected,
+	}
+	m.unsubscribe = trackDependentHelper(s, DependentComputed, reactionFunc(m.sync))
+	return m
+}
+
+// sync mirrors the source value into the embedded signal.
+// Reading the source inside its transform serializes concurrent syncs,
+// so the last one to run always publishes the latest source value.
+func (m *mappedSignal[T, U]) sync() {
+	m.signal.Update(func(U) U { return m.to(m.source.Get()) })
+}
+
+// Set converts value and writes it to the source.
+func (m *mappedSignal[T, U]) Set(value U) {
+	_ = m.SetE(value)
+}
+
+// SetE converts value and writes it to the source, returning the
+// validation, conversion, or source error.
+func (m *mappedSignal[T, U]) SetE(value U) error {
+	t, err := m.convert(value)
+	if err != nil {
+		return err
+	}
+	return m.source.SetE(t)
+}
+
+// SetSafe converts value and writes it with the source's SetSafe, or SetE
+// if the source is no SafeSetter. Panics of this signal's subscribers are
+// reported to OnPanic, since they run when the source change is mirrored.
+func (m *mappedSignal[T, U]) SetSafe(value U) []error {
+	t, err := m.convert(value)
+	if err != nil {
+		return []error{err}
+	}
+	if s, ok := m.source.(SafeSetter[T]); ok {
+		return s.SetSafe(t)
+	}
+	if err := m.source.SetE(t); err != nil {
+		return []error{err}
+	}
+	return nil
+}
+
+// SetWithMeta converts value and writes it to the source with meta, which
+// the source's SubscribeMeta subscribers receive. A source that is not a
+// MetaSetter is written with SetE and the meta is dropped. This signal's
+// own SubscribeMeta subscribers see the mirrored change with nil meta.
+func (m *mappedSignal[T, U]) SetWithMeta(value U, meta any) {
+	t, err := m.convert(value)
+	if err != nil {
+		return
+	}
+	if ms, ok := m.source.(MetaSetter[T]); ok {
+		ms.SetWithMeta(t, meta)
+		return
+	}
+	_ = m.source.SetE(t)
+}
+
+// Update applies fn to the converted value atomically on the source.
+// Neither Validate nor conversion panic recovery apply; keep fn and from total.
+func (m *mappedSignal[T, U]) Update(fn func(U) U) {
+	m.source.Update(func(t T) T { return m.from(fn(m.to(t))) })
+}
+
+// UpdateAndGet is like Update but returns the converted value committed to
+// the source. If the source is no UpdateGetter, it returns the value fn
+// produced, which the source's Equal or Validate may have dropped.
+func (m *mappedSignal[T, U]) UpdateAndGet(fn func(U) U) U {
+	convert := func(t T) T { return m.from(fn(m.to(t))) }
+	if s, ok := m.source.(UpdateGetter[T]); ok {
+		return m.to(s.UpdateAndGet(convert))
+	}
+	var produced T
+	m.source.Update(func(t T) T {
+		produced = convert(t)
+		return produced
+	})
+	return m.to(produced)
+}
+
+// CompareAndSwap writes new only if the converted current value equals old
+// (by Options.Equal, else ==, as for signals of New). The swap is
+// committed with the source's CompareAndSwap, so T must also support it,
+// and it always fails if the source is no CompareAndSwapper.
+func (m *mappedSignal[T, U]) CompareAndSwap(old, new U) bool {
+	source, ok := m.source.(CompareAndSwapper[T])
+	if !ok {
+		return false
+	}
+	cur := m.source.Get()
+	matches := m.equalFunc()
+	if matches == nil {
+		if matches = comparableEqual[U](); matches == nil {
+			panic("signals: CompareAndSwap on a non-comparable type requires Options.Equal")
+		}
+	}
+	if !matches(m.to(cur), old) {
+		return false
+	}
+
+	t, err := m.convert(new)
+	if err != nil {
+		return false
+	}
+	return source.CompareAndSwap(cur, t)
+}
+
+// ReplaceIf writes newValue only if pred accepts the converted current
+// value, atomically on the source. It always fails if the source is no
+// Replacer.
+func (m *mappedSignal[T, U]) ReplaceIf(pred func(U) bool, newValue U) bool {
+	source, ok := m.source.(Replacer[T])
+	if !ok {
+		return false
+	}
+	t, err := m.convert(newValue)
+	if err != nil {
+		return false
+	}
+	return source.ReplaceIf(func(cur T) bool { return pred(m.to(cur)) }, t)
+}
+
+// Close stops mirroring the source and closes the converted signal.
+// The source itself stays open.
+func (m *mappedSignal[T, U]) Close() {
+	m.unsubscribe()
+	m.signal.Close()
+}
+
+// CloseWith writes a final value through to the source, then closes.
+func (m *mappedSignal[T, U]) CloseWith(final U) {
+	m.Set(final)
+	m.Close()
+}
+
+// convert validates value and converts it with from, recovering panics.
+func (m *mappedSignal[T, U]) convert(value U) (t T, err error) {
+	if m.validator != nil {
+		if err := m.validator(value); err != nil {
+			if m.onRejected != nil {
+				m.onRejected(value, err)
+			}
+			return t, err
+		}
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			pe := &PanicError{Value: r, Stack: debug.Stack()}
+			if m.onPanic != nil {
+				m.onPanic(r, pe.Stack)
+			} else {
+				logPanic(m.logger, fmt.Sprintf("converting %v", value), r, pe.Stack)
+			}
+			err = pe
+		}
+	}()
+	return m.from(value), nil
+}
@@ -0,0 +1,190 @@
+package signals
+
+import (
+	"errors"
+	"slices"
+	"strconv"
+	"sync/atomic"
+	"testing"
+)
+
+// TestMapTwoWay_IntString verifies reads and writes convert in both directions
+func TestMapTwoWay_IntString(t *testing.T) {
+	num := New(42)
+	text := MapTwoWay(num, strconv.Itoa, func(s string) int {
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			panic(err)
+		}
+		return n
+	})
+
+	if got := text.Get(); got != "42" {
+		t.Fatalf("Get() = %q, want %q", got, "42")
+	}
+
+	var seen []string
+	defer text.SubscribeForever(func(s string) { seen = append(seen, s) })()
+
+	num.Set(7)
+	if got := text.Get(); got != "7" {
+		t.Errorf("after source Set(7), Get() = %q, want %q", got, "7")
+	}
+
+	text.Set("100")
+	if got := num.Get(); got != 100 {
+		t.Errorf("after Set(\"100\"), source = %d, want 100", got)
+	}
+
+	text.Update(func(s string) string { return s + "0" })
+	if got := num.Get(); got != 1000 {
+		t.Errorf("after Update, source = %d, want 1000", got)
+	}
+
+	if want := []string{"7", "100", "1000"}; !slices.Equal(seen, want) {
+		t.Errorf("subscriber saw %v, want %v", seen, want)
+	}
+}
+
+// TestMapTwoWay_ParseErrors verifies unparsable writes are surfaced and dropped
+func TestMapTwoWay_ParseErrors(t *testing.T) {
+	num := New(1)
+	var panics, rejections int32
+
+	atoi := func(s string) int {
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			panic(err)
+		}
+		return n
+	}
+	text := MapTwoWayWithOptions(num, strconv.Itoa, atoi, Options[string]{
+		OnPanic: func(any, []byte) { atomic.AddInt32(&panics, 1) },
+		Validate: func(s string) error {
+			if s == "" {
+				return errors.New("empty")
+			}
+			return nil
+		},
+		OnRejected: func(string, error) { atomic.AddInt32(&rejections, 1) },
+	})
+
+	// Caught by Validate
+	if err := text.SetE(""); err == nil || err.Error() != "empty" {
+		t.Errorf("SetE(\"\") = %v, want empty", err)
+	}
+
+	// Caught by the conversion panic
+	err := text.SetE("abc")
+	var pe *PanicError
+	if !errors.As(err, &pe) {
+		t.Errorf("SetE(\"abc\") = %v, want *PanicError", err)
+	}
+	var numErr *strconv.NumError
+	if !errors.As(err, &numErr) {
+		t.Errorf("SetE(\"abc\") = %v, want to unwrap to *strconv.NumError", err)
+	}
+
+	if got := num.Get(); got != 1 {
+		t.Errorf("source = %d after rejected writes, want 1", got)
+	}
+	if got := text.Get(); got != "1" {
+		t.Errorf("Get() = %q after rejected writes, want %q", got, "1")
+	}
+	if atomic.LoadInt32(&panics) != 1 || atomic.LoadInt32(&rejections) != 1 {
+		t.Errorf("panics = %d, rejections = %d, want 1 each", panics, rejections)
+	}
+}
+
+// TestMapTwoWay_CompareAndSwap verifies CAS compares converted values
+func TestMapTwoWay_CompareAndSwap(t *testing.T) {
+	num := New(5)
+	text := MapTwoWay(num, strconv.Itoa, func(s string) int {
+		n, _ := strconv.Atoi(s)
+		return n
+	})
+	cas := text.(CompareAndSwapper[string])
+
+	if cas.CompareAndSwap("4", "6") {
+		t.Error("CompareAndSwap(\"4\", \"6\") on \"5\" succeeded")
+	}
+	if !cas.CompareAndSwap("5", "6") {
+		t.Error("CompareAndSwap(\"5\", \"6\") on \"5\" failed")
+	}
+	if got := num.Get(); got != 6 {
+		t.Errorf("source = %d, want 6", got)
+	}
+}
+
+// TestMapTwoWay_CompareAndSwapUncomparableDynamic verifies CAS on a view holding a slice in an any doesn't panic
+func TestMapTwoWay_CompareAndSwapUncomparableDynamic(t *testing.T) {
+	num := New(5)
+	view := MapTwoWay(num, func(n int) any { return []int{n} }, func(v any) int { return len(v.([]int)) })
+
+	if view.(CompareAndSwapper[any]).CompareAndSwap([]int{5}, []int{1}) {
+		t.Error("CompareAndSwap matched a slice value, want no match")
+	}
+	if got := num.Get(); got != 5 {
+		t.Errorf("source = %d, want 5", got)
+	}
+}
+
+// TestMapTwoWay_ReplaceIf verifies pred sees converted values and the swap reaches the source
+func TestMapTwoWay_ReplaceIf(t *testing.T) {
+	num := New(5)
+	text := MapTwoWay(num, strconv.Itoa, func(s string) int {
+		n, _ := strconv.Atoi(s)
+		return n
+	})
+
+	replacer := text.(Replacer[string])
+	if replacer.ReplaceIf(func(s string) bool { return s == "4" }, "6") {
+		t.Error("ReplaceIf matching \"4\" on \"5\" succeeded")
+	}
+	if !replacer.ReplaceIf(func(s string) bool { return s == "5" }, "6") {
+		t.Error("ReplaceIf matching \"5\" on \"5\" failed")
+	}
+	if got := num.Get(); got != 6 {
+		t.Errorf("source = %d, want 6", got)
+	}
+}
+
+// TestMapTwoWay_Close verifies Close stops mirroring without closing the source
+func TestMapTwoWay_Close(t *testing.T) {
+	num := New(1)
+	text := MapTwoWay(num, strconv.Itoa, func(s string) int {
+		n, _ := strconv.Atoi(s)
+		return n
+	})
+
+	text.Close()
+	num.Set(2)
+
+	if got := text.Get(); got != "1" {
+		t.Errorf("Get() after Close = %q, want %q", got, "1")
+	}
+	if d := num.Dependents(); d.Total() != 0 {
+		t.Errorf("source Dependents() after Close = %+v, want none", d)
+	}
+}
+
+// TestMapTwoWay_SetWithMeta verifies SetWithMeta converts the value and writes it to the source with its meta
+func TestMapTwoWay_SetWithMeta(t *testing.T) {
+	n := New(1)
+	text := MapTwoWay(n, strconv.Itoa, func(s string) int { v, _ := strconv.Atoi(s); return v })
+	defer text.Close()
+	var metas []any
+	n.(MetaSubscriber[int]).SubscribeMeta(t.Context(), func(_ int, meta any) { metas = append(metas, meta) })
+
+	text.(MetaSetter[string]).SetWithMeta("5", "form")
+
+	if got := n.Get(); got != 5 {
+		t.Errorf("source Get() = %d, want 5", got)
+	}
+	if got := text.Get(); got != "5" {
+		t.Errorf("view Get() = %q, want 5", got)
+	}
+	if want := []any{"form"}; !slices.Equal(metas, want) {
+		t.Errorf("source meta = %v, want %v", metas, want)
+	}
+}
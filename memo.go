@@ -0,0 +1,73 @@
+package signals
+
+import lru "container/list"
+
+// memoCache is a least-recently-used cache of fn results by key.
+// It is only used from compute, which the computed serializes under its lock.
+type memoCache[K comparable, V any] struct {
+	fn         func(K) V
+	maxEntries int
+
+	// order holds *memoEntry values, most recently used at the front
+	order   *lru.List
+	entries map[K]*lru.Element
+}
+
+// memoEntry is a cached result.
+type memoEntry[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+// Memo creates a read-only signal holding fn(input.Get()), caching the
+// results for up to maxEntries distinct keys.
+//
+// Unlike Computed, which only remembers the latest result, Memo keeps
+// previously computed values in an LRU cache, so returning to a recently
+// seen key doesn't call fn again. fn must be pure: a key always maps to
+// the same value. With maxEntries <= 0, only the current key is cached.
+//
+// Call Cleanup to unsubscribe from input once the signal is no longer
+// needed.
+//
+// Example:
+//
+//	tab := signals.New("overview")
+//	content := signals.Memo(tab.AsReadonly(), renderTab, 8)
+//	defer content.Cleanup()
+//
+//	content.Get()     // renderTab("overview")
+//	tab.Set("stats")  // renderTab("stats")
+//	tab.Set("overview")
+//	content.Get()     // Cached - renderTab not called
+func Memo[K comparable, V any](input ReadonlySignal[K], fn func(K) V, maxEntries int) ComputedSignal[V] {
+	m := &memoCache[K, V]{
+		fn:         fn,
+		maxEntries: max(maxEntries, 1),
+		order:      lru.New(),
+		entries:    make(map[K]*lru.Element),
+	}
+	return Computed(func() V {
+		return m.get(input.Get())
+	}, input)
+}
+
+// get returns the cached value for key, calling fn on a miss and evicting
+// the least recently used entry when full.
+func (m *memoCache[K, V]) get(key K) V {
+	if el, ok := m.entries[key]; ok {
+		m.order.MoveToFront(el)
+		return el.Value.(*memoEntry[K, V]).value
+	}
+
+	// Compute before inserting, so a panicking fn caches nothing
+	value := m.fn(key)
+	m.entries[key] = m.order.PushFront(&memoEntry[K, V]{key: key, value: value})
+
+	if m.order.Len() > m.maxEntries {
+		oldest := m.order.Back()
+		m.order.Remove(oldest)
+		delete(m.entries, oldest.Value.(*memoEntry[K, V]).key)
+	}
+	return value
+}
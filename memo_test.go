@@ -0,0 +1,103 @@
+package signals
+
+import "testing"
+
+// TestMemo_CachedKeysSkipFn verifies switching back to a cached key does not call fn
+func TestMemo_CachedKeysSkipFn(t *testing.T) {
+	tab := New("a")
+	calls := make(map[string]int)
+	memo := Memo(tab.AsReadonly(), func(k string) string {
+		calls[k]++
+		return "content:" + k
+	}, 2)
+
+	if got := memo.Get(); got != "content:a" {
+		t.Errorf("Get() = %q, want content:a", got)
+	}
+	tab.Set("b")
+	tab.Set("a")
+	tab.Set("b")
+
+	if got := memo.Get(); got != "content:b" {
+		t.Errorf("Get() = %q, want content:b", got)
+	}
+	if calls["a"] != 1 || calls["b"] != 1 {
+		t.Errorf("fn calls = %v, want one per key", calls)
+	}
+}
+
+// TestMemo_EvictsLeastRecentlyUsed verifies the LRU bound
+func TestMemo_EvictsLeastRecentlyUsed(t *testing.T) {
+	key := New(1)
+	calls := make(map[int]int)
+	memo := Memo(key.AsReadonly(), func(k int) int {
+		calls[k]++
+		return k * 10
+	}, 2)
+
+	// Read after each Set: an unobserved memo only recomputes on Get
+	memo.Get() // 1
+	for _, k := range []int{
+		2, // 1, 2
+		1, // 2, 1 (1 most recent)
+		3, // evicts 2
+		1, // cached
+		2, // recomputed
+	} {
+		key.Set(k)
+		memo.Get()
+	}
+
+	if got := memo.Get(); got != 20 {
+		t.Errorf("Get() = %d, want 20", got)
+	}
+	want := map[int]int{1: 1, 2: 2, 3: 1}
+	for k, n := range want {
+		if calls[k] != n {
+			t.Errorf("fn(%d) called %d times, want %d", k, calls[k], n)
+		}
+	}
+}
+
+// TestMemo_Subscribe verifies subscribers see values for each key change
+func TestMemo_Subscribe(t *testing.T) {
+	key := New(1)
+	memo := Memo(key.AsReadonly(), func(k int) int { return k * 10 }, 0)
+
+	var got []int
+	memo.SubscribeForever(func(v int) { got = append(got, v) })
+
+	key.Set(2)
+	key.Set(1)
+
+	if len(got) != 2 || got[0] != 20 || got[1] != 10 {
+		t.Errorf("notifications = %v, want [20 10]", got)
+	}
+}
+
+// TestMemo_Cleanup verifies Cleanup unsubscribes from input, keeping the last value
+func TestMemo_Cleanup(t *testing.T) {
+	key := New(1)
+	calls := 0
+	memo := Memo(key.AsReadonly(), func(k int) int {
+		calls++
+		return k * 10
+	}, 4)
+
+	memo.Get()
+	if n := key.Dependents().Computed; n != 1 {
+		t.Fatalf("input has %d computed dependents, want 1", n)
+	}
+	memo.Cleanup()
+	if n := key.Dependents().Computed; n != 0 {
+		t.Errorf("input has %d computed dependents after Cleanup, want 0", n)
+	}
+
+	key.Set(2)
+	if got := memo.Get(); got != 10 {
+		t.Errorf("Get() after Cleanup = %d, want 10", got)
+	}
+	if calls != 1 {
+		t.Errorf("fn calls = %d, want 1", calls)
+	}
+}
@@ -1,5 +1,14 @@
 package signals
 
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"reflect"
+	"time"
+	"unsafe"
+)
+
 // EqualFunc is a function that compares two values for equality.
 // It returns true if the values are considered equal, false otherwise.
 //
@@ -28,11 +37,22 @@ type EqualFunc[T any] func(a, b T) bool
 
 // Options configures the behavior of a Signal.
 type Options[T any] struct {
+	// Initial is the initial value of a signal created with FromOptions,
+	// so generic factory code can build signals from a config struct alone.
+	// Constructors taking an initial value argument ignore it.
+	Initial T
+
 	// Equal is an optional custom equality function.
 	// If nil, signals will not perform equality checks and always notify on Set().
+	// A computed signal doesn't notify a recomputed value Equal to the cached one.
 	//
 	// Angular Signals use Object.is() by default (referential equality).
 	// For Go, we allow optional equality checks since not all types are comparable.
+	//
+	// Signals of non-comparable types (funcs, maps, slices, or structs holding
+	// them) work without Equal: nothing is compared, so every Set notifies.
+	// Don't use == or reflect.DeepEqual on such values (both can panic or are
+	// unreliable for funcs); use IdentityEqual to compare references instead.
 	Equal EqualFunc[T]
 
 	// OnPanic is an optional custom panic handler for subscriber callbacks.
@@ -47,4 +67,375 @@ type Options[T any] struct {
 	//       metrics.IncrementPanicCounter()
 	//   }
 	OnPanic func(err any, stack []byte)
+
+	// Logger receives panics and internal errors when OnPanic is nil, as
+	// error-level records with the panic value and stack as attributes.
+	// If nil, they are written with the standard log package.
+	Logger *slog.Logger
+
+	// RePanicOn, if set, is asked about each panic of a writable signal's
+	// subscriber once it has been reported (to OnPanic or the log). If it
+	// returns true, the panic is raised again from the write that notified,
+	// skipping the remaining subscribers, so the process can fail fast on
+	// programmer errors while benign panics stay isolated.
+	//
+	// Example:
+	//   RePanicOn: func(r any) bool {
+	//       _, fatal := r.(runtime.Error) // nil map writes, bad indexes, ...
+	//       return fatal
+	//   }
+	RePanicOn func(r any) bool
+
+	// Validate is an optional hook that rejects invalid writes to a writable signal.
+	// If it returns a non-nil error, Set/Update leave the value unchanged
+	// and subscribers are not notified. For Update, the value produced by
+	// the transform function is validated.
+	//
+	// Validate runs on every write (under the write lock for Update),
+	// so keep it fast and free of side effects.
+	//
+	// Example:
+	//   Validate: func(age int) error {
+	//       if age < 0 {
+	//           return errors.New("age must be non-negative")
+	//       }
+	//       return nil
+	//   }
+	Validate func(value T) error
+
+	// OnRejected is called when Validate rejects a write.
+	// It receives the rejected value and the validation error.
+	// If nil, rejected writes are dropped silently (use SetE to observe them).
+	OnRejected func(value T, err error)
+
+	// Interceptors run in order on every Set/Update of a writable signal,
+	// before equality checks, validation, and notification. Each receives
+	// the current value and the incoming value and returns the value to
+	// pass on; the final result is what gets stored and broadcast.
+	//
+	// Use interceptors to transform writes (e.g., clamping) or to observe
+	// them (e.g., audit logging, returning new unchanged).
+	//
+	// Interceptors run under the write lock, so they must be fast and must
+	// not access the signal itself.
+	//
+	// Example:
+	//   Interceptors: []func(old, new int) int{
+	//       func(_, v int) int { return min(max(v, 0), 100) },
+	//   }
+	Interceptors []func(old, new T) T
+
+	// LockFreeReads stores the value behind an atomic pointer so Get never
+	// takes a lock. Writes still lock to order notifications, and each write
+	// allocates a copy of the value.
+	//
+	// Use it for very hot signals read by many goroutines concurrently,
+	// where RWMutex contention on Get dominates.
+	LockFreeReads bool
+
+	// StrongConsistency makes each write notify the signal's subscribers
+	// before any Get can observe the new value: readers wait from the
+	// commit until every subscriber has returned, then computeds and
+	// effects run. By default, subscribers are notified after the write
+	// lock is released, so a concurrent Get may see a value whose
+	// notification is still in flight.
+	//
+	// The default avoids holding a lock while running user code. With
+	// StrongConsistency, a slow subscriber blocks every reader and writer,
+	// and a subscriber that reads or writes the signal it is notified by
+	// deadlocks (use the value it receives instead). Computeds and effects
+	// are unaffected, as they run once readers are let in again. Writes
+	// committed by Transaction are not covered.
+	StrongConsistency bool
+
+	// ComputeTimeout bounds how long Get on a computed signal waits for its
+	// compute function. If zero, Get waits for compute to finish (default).
+	// Only ComputedWithOptions uses it.
+	//
+	// When set, compute runs on its own goroutine, and the deadline is
+	// measured by Clock. If compute doesn't finish in time, Get returns
+	// FallbackValue, which is cached until the real result arrives. The late
+	// result then replaces it and subscribers are notified.
+	//
+	// At most one compute runs per computed: until a late one returns, Get
+	// returns the cached value without starting another, and the changes
+	// made meanwhile are coalesced into a single rerun whose result is
+	// published instead. A compute that never returns blocks its computed
+	// on the fallback and keeps its goroutine.
+	//
+	// Use it to protect latency-sensitive read paths from slow derivations.
+	ComputeTimeout time.Duration
+
+	// FallbackValue is returned by a computed signal whose compute exceeds
+	// ComputeTimeout.
+	FallbackValue T
+
+	// CoalesceWindow, if positive, collapses bursts of dependency changes
+	// of a computed signal: the first change of a burst starts the window,
+	// and when it ends the computed recomputes and notifies subscribers,
+	// computeds, and effects downstream once for all the changes in it.
+	// Only ComputedWithOptions uses it.
+	//
+	// The computed is dirty during the window, so its Get still returns a
+	// fresh value; computeds reading it catch up when the window ends.
+	// Use it for computeds fed by chatty dependencies, trading how soon
+	// changes are notified for fewer notifications.
+	CoalesceWindow time.Duration
+
+	// WeakDependencies stops a computed signal's dependencies from keeping
+	// it alive: once the caller drops every reference to it, the computed
+	// unsubscribes from its dependencies when the garbage collector
+	// notices, as if Cleanup had been called. Only ComputedWithOptions
+	// uses it.
+	//
+	// This is best-effort and happens on the garbage collector's schedule,
+	// so it may be delayed arbitrarily; calling Cleanup is still the
+	// deterministic way to release a computed. Subscribers don't keep the
+	// computed alive, while computeds and effects depending on it do.
+	WeakDependencies bool
+
+	// Clock measures ComputeTimeout, CoalesceWindow, MaxAge, and the
+	// countdown of NewAutoReset. If nil, the real clock is used. Inject a
+	// FakeClock to test them without sleeping.
+	Clock Clock
+
+	// OnTiming, if set, receives how long user code took, to find slow
+	// derivations and subscribers in production:
+	//   - TimingCompute: each run of a computed's compute function
+	//   - TimingNotify: the notification of each change, i.e. all the
+	//     computeds, effects, and subscribers it runs (for a computed signal,
+	//     its subscribers)
+	//
+	// If nil, nothing is timed. OnTiming is called synchronously on the
+	// goroutine that did the work, so keep it fast.
+	//
+	// Example:
+	//   OnTiming: func(op string, d time.Duration) {
+	//       metrics.ObserveLatency("cart_total_"+op, d)
+	//   }
+	OnTiming func(op string, d time.Duration)
+
+	// RecordHistory makes a writable signal record every value it holds,
+	// with the time it was stored, for debugging (see HistoryReader).
+	// Off by default; then nothing is recorded and writes pay a nil check.
+	RecordHistory bool
+
+	// HistoryLimit bounds how many values RecordHistory keeps, dropping the
+	// oldest first. If zero, DefaultHistoryLimit applies.
+	HistoryLimit int
+
+	// TrackDeliveries makes a writable signal record, per subscriber, the
+	// last value it was notified with, when, and how often it panicked (see
+	// DeliveryReader). Use it to find out why a listener went stale. Off by
+	// default; then subscribers are called directly, with no overhead.
+	TrackDeliveries bool
+
+	// MaxSubscribers caps the number of subscribers of a writable signal,
+	// as a safety valve against subscription leaks in long-running
+	// services. Once reached, Subscribe registers nothing, returns a no-op
+	// Unsubscribe, and reports an error wrapping ErrSubscriberLimit to
+	// OnLimit. Computeds and effects don't count. Zero means unlimited.
+	MaxSubscribers int
+
+	// OnLimit is called with each subscription refused at MaxSubscribers.
+	// If nil, the error is logged (via Logger if set).
+	OnLimit func(err error)
+
+	// SlowSubscriberThreshold, if positive, times every subscriber callback
+	// of a writable or computed signal and reports those running longer to
+	// OnSlow, with the callback's name and the notifying stack. Use it to
+	// find the listener stalling writes. Off by default; then subscribers
+	// are called directly, with no overhead.
+	SlowSubscriberThreshold time.Duration
+
+	// OnSlow is called, on the notifying goroutine, with each callback
+	// exceeding SlowSubscriberThreshold. If nil, it is logged at warn level
+	// (via Logger if set).
+	OnSlow func(SlowSubscriber)
+
+	// MaxAge, with Refresh, bounds the age of a writable signal's value, for
+	// signals mirroring an external source: a Get finding the value older
+	// than MaxAge (since it was stored or last refreshed) first calls
+	// Refresh and sets the result, turning the signal into a cache with a TTL.
+	//
+	// Concurrent Gets of a stale value wait for a single Refresh. If it
+	// fails, the error (wrapping ErrRefreshFailed) goes to OnPanic or the
+	// log, and the stale value is served for another MaxAge.
+	//
+	// Example:
+	//   MaxAge:  time.Minute,
+	//   Refresh: func() (Rates, error) { return api.FetchRates(ctx) },
+	MaxAge time.Duration
+
+	// Refresh re-fetches the value of a signal older than MaxAge.
+	Refresh func() (T, error)
+}
+
+// Operations reported to Options.OnTiming.
+const (
+	// TimingCompute is a run of a computed signal's compute function.
+	TimingCompute = "compute"
+
+	// TimingNotify is the notification of a change to subscribers,
+	// computeds, and effects.
+	TimingNotify = "notify"
+)
+
+// firstOptions returns the first of optional Options, or the zero Options.
+func firstOptions[T any](opts []Options[T]) Options[T] {
+	if len(opts) == 0 {
+		return Options[T]{}
+	}
+	return opts[0]
+}
+
+// reportTiming passes the time elapsed since start to onTiming.
+// Meant to be deferred with start set to time.Now().
+func reportTiming(onTiming func(string, time.Duration), op string, start time.Time) {
+	onTiming(op, time.Since(start))
+}
+
+// ErrInvalidOptions is wrapped by the errors returned from Options.Check.
+var ErrInvalidOptions = errors.New("signals: invalid options")
+
+// Check reports option combinations that cannot work as configured:
+//   - OnRejected without Validate: nothing is ever rejected, so it never runs
+//   - a nil entry in Interceptors: the first write would panic
+//   - a negative MaxSubscribers, or OnLimit without MaxSubscribers
+//   - OnSlow without SlowSubscriberThreshold
+//   - MaxAge without Refresh, or the reverse
+//
+// NewWithOptions accepts any Options; use MustNew to fail fast on these.
+func (o Options[T]) Check() error {
+	if o.OnRejected != nil && o.Validate == nil {
+		return fmt.Errorf("%w: OnRejected is set without Validate", ErrInvalidOptions)
+	}
+	for i, fn := range o.Interceptors {
+		if fn == nil {
+			return fmt.Errorf("%w: Interceptors[%d] is nil", ErrInvalidOptions, i)
+		}
+	}
+	if o.MaxSubscribers < 0 {
+		return fmt.Errorf("%w: MaxSubscribers is negative", ErrInvalidOptions)
+	}
+	if o.OnLimit != nil && o.MaxSubscribers == 0 {
+		return fmt.Errorf("%w: OnLimit is set without MaxSubscribers", ErrInvalidOptions)
+	}
+	if o.OnSlow != nil && o.SlowSubscriberThreshold <= 0 {
+		return fmt.Errorf("%w: OnSlow is set without SlowSubscriberThreshold", ErrInvalidOptions)
+	}
+	if (o.MaxAge > 0) != (o.Refresh != nil) {
+		return fmt.Errorf("%w: MaxAge and Refresh must be set together", ErrInvalidOptions)
+	}
+	return nil
+}
+
+// EqualBy returns an EqualFunc that considers two values equal when their
+// keys are equal. Use it when only part of a value determines identity.
+//
+// Example:
+//
+//	user := signals.NewWithOptions(User{ID: 1, Name: "Alice"}, signals.Options[User]{
+//	    Equal: signals.EqualBy(func(u User) int { return u.ID }),
+//	})
+//	user.Set(User{ID: 1, Name: "Alicia"}) // No notification - same ID
+func EqualBy[T any, K comparable](key func(T) K) EqualFunc[T] {
+	return func(a, b T) bool {
+		return key(a) == key(b)
+	}
+}
+
+// IdentityEqual returns an EqualFunc that compares reference types by
+// identity, the way Angular compares with Object.is: two funcs, maps,
+// channels, or pointers are equal only if they are the same one, and two
+// slices only if they share backing array and length. Other values are
+// compared with == when comparable, and are otherwise never equal.
+//
+// It never panics, including for interface types holding non-comparable
+// values. Comparisons use reflection; prefer a typed Equal for hot signals.
+//
+// Example:
+//
+//	handler := signals.NewWithOptions(defaultHandler, signals.Options[func()]{
+//	    Equal: signals.IdentityEqual[func()](),
+//	})
+//	handler.Set(defaultHandler) // No notification - same func
+func IdentityEqual[T any]() EqualFunc[T] {
+	return func(a, b T) bool {
+		return sameIdentity(reflect.ValueOf(&a).Elem(), reflect.ValueOf(&b).Elem())
+	}
+}
+
+// sameIdentity implements IdentityEqual for two values of the same type.
+func sameIdentity(a, b reflect.Value) bool {
+	if a.Kind() == reflect.Interface {
+		if a.IsNil() || b.IsNil() {
+			return a.IsNil() && b.IsNil()
+		}
+		a, b = a.Elem(), b.Elem()
+		if a.Type() != b.Type() {
+			return false
+		}
+	}
+
+	switch a.Kind() {
+	case reflect.Func:
+		return funcIdentity(a) == funcIdentity(b)
+	case reflect.Map, reflect.Chan, reflect.Pointer, reflect.UnsafePointer:
+		return a.UnsafePointer() == b.UnsafePointer()
+	case reflect.Slice:
+		return a.UnsafePointer() == b.UnsafePointer() && a.Len() == b.Len()
+	default:
+		return a.Comparable() && a.Equal(b)
+	}
+}
+
+// funcIdentity returns the closure a func value points to. Unlike
+// reflect.Value.Pointer, which returns the code pointer shared by every
+// closure created from the same function literal, it tells closures apart.
+func funcIdentity(v reflect.Value) unsafe.Pointer {
+	if !v.CanAddr() {
+		p := reflect.New(v.Type()).Elem()
+		p.Set(v)
+		v = p
+	}
+	return *(*unsafe.Pointer)(v.Addr().UnsafePointer())
+}
+
+// comparableEqual returns an EqualFunc using ==, or nil if T is not
+// comparable. For types holding interfaces (directly, or in struct fields
+// or array elements), values whose dynamic types are not comparable are
+// reported unequal instead of panicking.
+func comparableEqual[T any]() EqualFunc[T] {
+	t := reflect.TypeFor[T]()
+	if !t.Comparable() {
+		return nil
+	}
+	if !holdsInterface(t) {
+		return func(a, b T) bool { return any(a) == any(b) }
+	}
+	return func(a, b T) bool {
+		if !reflect.ValueOf(&a).Elem().Comparable() || !reflect.ValueOf(&b).Elem().Comparable() {
+			return false
+		}
+		return any(a) == any(b)
+	}
+}
+
+// holdsInterface reports whether values of the comparable type t contain
+// an interface, whose == panics for a non-comparable dynamic type.
+func holdsInterface(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Interface:
+		return true
+	case reflect.Array:
+		return holdsInterface(t.Elem())
+	case reflect.Struct:
+		for i := range t.NumField() {
+			if holdsInterface(t.Field(i).Type) {
+				return true
+			}
+		}
+	}
+	return false
 }
@@ -0,0 +1,116 @@
+package signals
+
+import (
+	"slices"
+	"sync"
+	"time"
+)
+
+// Pipeline composes operators on a signal, one stage at a time. Start one
+// with Pipe, chain same-type stages with its methods, change the type with
+// PipeMap, and finish with Build.
+//
+// Each stage is the package's own operator (a computed, RateLimit, ...),
+// so a pipeline behaves exactly like the nested calls it replaces. Stages
+// producing new values take optional Options for the derived signal, such
+// as Equal to drop duplicates or OnPanic; only the first is used.
+//
+// Example:
+//
+//	results := signals.PipeMap(
+//	    signals.Pipe(query.AsReadonly()).
+//	        Map(strings.TrimSpace).
+//	        Filter(func(q string) bool { return len(q) >= 3 }).
+//	        Debounce(300*time.Millisecond),
+//	    search,
+//	).Build()
+//	defer results.Stop()
+type Pipeline[T any] struct {
+	// last is the output of the latest stage
+	last ReadonlySignal[T]
+
+	// stops tears down the stages, in the order they were added
+	stops []func()
+}
+
+// Pipe starts a pipeline on source. The source itself is not stopped by
+// the built signal.
+func Pipe[T any](source ReadonlySignal[T]) *Pipeline[T] {
+	return &Pipeline[T]{last: source}
+}
+
+// PipeMap adds a stage converting each value with fn, as a computed
+// created with opts. It is a function rather than a method because Go
+// methods can't have type parameters.
+func PipeMap[T, U any](p *Pipeline[T], fn func(T) U, opts ...Options[U]) *Pipeline[U] {
+	src := p.last
+	c := ComputedWithOptions(func() U { return fn(src.Get()) }, firstOptions(opts), src)
+	return &Pipeline[U]{last: c, stops: append(slices.Clip(p.stops), c.Cleanup)}
+}
+
+// Map adds a stage transforming each value with fn. Use PipeMap to
+// change the value type.
+func (p *Pipeline[T]) Map(fn func(T) T, opts ...Options[T]) *Pipeline[T] {
+	return PipeMap(p, fn, opts...)
+}
+
+// Filter adds a stage passing on only the values pred accepts. Until a
+// value is accepted, the stage holds the source's value if pred accepts
+// it, or the zero value.
+func (p *Pipeline[T]) Filter(pred func(T) bool, opts ...Options[T]) *Pipeline[T] {
+	src := p.last
+	var initial T
+	if v := src.Get(); pred(v) {
+		initial = v
+	}
+	out := newSignal(initial, firstOptions(opts))
+	unsub := src.SubscribeForever(func(v T) {
+		if pred(v) {
+			out.Set(v)
+		}
+	})
+	return p.then(out, unsub)
+}
+
+// Debounce adds a stage passing on the last value of each burst of changes,
+// once the source has been quiet for window (RateLimit with Trailing).
+func (p *Pipeline[T]) Debounce(window time.Duration) *Pipeline[T] {
+	r := RateLimit(p.last, window, RateLimitOptions{Trailing: true})
+	return p.then(r, r.Stop)
+}
+
+// Throttle adds a stage passing on the first change of each burst right
+// away (RateLimit with Leading).
+func (p *Pipeline[T]) Throttle(window time.Duration) *Pipeline[T] {
+	r := RateLimit(p.last, window, RateLimitOptions{Leading: true})
+	return p.then(r, r.Stop)
+}
+
+// then returns the pipeline extended with a stage, leaving p unchanged.
+func (p *Pipeline[T]) then(stage ReadonlySignal[T], stop func()) *Pipeline[T] {
+	return &Pipeline[T]{last: stage, stops: append(slices.Clip(p.stops), stop)}
+}
+
+// Build returns the output of the last stage. Its Stop tears down every
+// stage, last first.
+func (p *Pipeline[T]) Build() CombinedSignal[T] {
+	return &piped[T]{ReadonlySignal: p.last, dependencyView: dependencyView[T]{source: p.last}, stops: slices.Clone(p.stops)}
+}
+
+// piped is the signal built by a Pipeline.
+type piped[T any] struct {
+	ReadonlySignal[T]
+	dependencyView[T]
+
+	stops    []func()
+	stopOnce sync.Once
+}
+
+// Stop tears down every stage, last first. Safe to call multiple times.
+func (p *piped[T]) Stop() {
+	p.stopOnce.Do(func() {
+		for _, stop := range slices.Backward(p.stops) {
+			stop()
+		}
+	})
+}
@@ -0,0 +1,125 @@
+package signals
+
+import (
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// TestPipe_ThreeStages verifies Map, Filter, and Debounce compose end to end
+func TestPipe_ThreeStages(t *testing.T) {
+	query := New("")
+	out := Pipe(query.AsReadonly()).
+		Map(strings.TrimSpace).
+		Filter(func(q string) bool { return len(q) >= 3 }).
+		Debounce(30 * time.Millisecond).
+		Build()
+	defer out.Stop()
+
+	var mu sync.Mutex
+	var got []string
+	out.SubscribeForever(func(q string) {
+		mu.Lock()
+		got = append(got, q)
+		mu.Unlock()
+	})
+
+	for _, q := range []string{" g", " go ", " gol", "gola ", "  golang  "} {
+		query.Set(q)
+	}
+	waitFor(t, func() bool { return out.Get() == "golang" })
+
+	query.Set("x") // Filtered out
+	time.Sleep(60 * time.Millisecond)
+
+	mu.Lock()
+	defer mu.Unlock()
+	if want := []string{"golang"}; !slices.Equal(got, want) {
+		t.Errorf("emitted %q, want %q (one debounced, trimmed, filtered value)", got, want)
+	}
+}
+
+// TestPipe_PipeMapChangesType verifies PipeMap stages convert the value type
+func TestPipe_PipeMapChangesType(t *testing.T) {
+	words := New("a b")
+	count := PipeMap(Pipe(words.AsReadonly()), strings.Fields)
+	lengths := PipeMap(count, func(f []string) int { return len(f) }).
+		Filter(func(n int) bool { return n%2 == 0 }).
+		Build()
+	defer lengths.Stop()
+
+	words.Set("a b c")
+	if got := lengths.Get(); got != 2 {
+		t.Errorf("Get() = %d, want 2 (odd counts filtered)", got)
+	}
+	words.Set("a b c d")
+	if got := lengths.Get(); got != 4 {
+		t.Errorf("Get() = %d, want 4", got)
+	}
+}
+
+// TestPipe_AsDependency verifies a built pipeline can drive computeds and effects, and Stop detaches it
+func TestPipe_AsDependency(t *testing.T) {
+	n := New(1)
+	doubled := Pipe(n.AsReadonly()).Map(func(v int) int { return v * 2 }).Build()
+
+	var seen []int
+	eff := Effect(func() { seen = append(seen, doubled.Get()) }, doubled)
+	defer eff.Stop()
+
+	n.Set(2)
+	doubled.Stop()
+	n.Set(3)
+
+	if want := []int{2, 4}; !slices.Equal(seen, want) {
+		t.Errorf("effect saw %v, want %v", seen, want)
+	}
+	if d := n.Dependents(); d.Total() != 0 {
+		t.Errorf("source Dependents() = %+v after Stop, want none", d)
+	}
+	doubled.Stop() // Idempotent
+}
+
+// TestPipe_MapEqualDropsDuplicates verifies a Map stage with Equal suppresses duplicate downstream notifications
+func TestPipe_MapEqualDropsDuplicates(t *testing.T) {
+	n := New(1)
+	parity := Pipe(n.AsReadonly()).
+		Map(func(v int) int { return v % 2 }, Options[int]{Equal: func(a, b int) bool { return a == b }}).
+		Build()
+	defer parity.Stop()
+
+	var got []int
+	parity.SubscribeForever(func(v int) { got = append(got, v) })
+	parity.Get() // 1
+
+	for _, v := range []int{3, 5, 6, 8, 9} {
+		n.Set(v)
+	}
+
+	if want := []int{0, 1}; !slices.Equal(got, want) {
+		t.Errorf("notified %v, want %v (duplicates dropped)", got, want)
+	}
+	if parity.Get() != 1 {
+		t.Errorf("Get() = %d, want 1", parity.Get())
+	}
+}
+
+// TestPipe_StageOnPanic verifies stage options route subscriber panics
+func TestPipe_StageOnPanic(t *testing.T) {
+	n := New(0)
+	var panics int
+	out := Pipe(n.AsReadonly()).
+		Filter(func(v int) bool { return v > 0 }, Options[int]{OnPanic: func(any, []byte) { panics++ }}).
+		Build()
+	defer out.Stop()
+
+	out.SubscribeForever(func(int) { panic("boom") })
+	n.Set(1)
+	n.Set(-1)
+
+	if panics != 1 {
+		t.Errorf("OnPanic called %d times, want 1", panics)
+	}
+}
@@ -0,0 +1,78 @@
+package signals
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+)
+
+// ErrImpureCompute is reported when a compute function writes a signal
+// while SetPurityCheck is on.
+var ErrImpureCompute = errors.New("signals: signal written during pure computation")
+
+// purityCheck is set while SetPurityCheck is on.
+var purityCheck atomic.Bool
+
+// computing counts the compute functions running on each goroutine, by
+// goroutine ID. Only maintained while purityCheck is set.
+var computing struct {
+	mu     sync.Mutex
+	depths map[uint64]int
+}
+
+// SetPurityCheck turns on the development-mode check that compute
+// functions are pure: while a computed's compute runs, a write to any
+// signal from the same goroutine is reported as ErrImpureCompute to the
+// written signal's OnPanic (or logged), with the stack of the write. The
+// write itself still happens.
+//
+// The check is off by default and then costs a single atomic load per
+// compute and per write. When on, each compute and write looks up the
+// calling goroutine, so keep it to development and tests.
+//
+// Example:
+//
+//	func TestMain(m *testing.M) {
+//	    signals.SetPurityCheck(true)
+//	    os.Exit(m.Run())
+//	}
+func SetPurityCheck(enabled bool) {
+	purityCheck.Store(enabled)
+}
+
+// enterCompute records a compute starting on the calling goroutine and
+// returns its ID for leaveCompute.
+func enterCompute() uint64 {
+	id := goroutineID()
+	computing.mu.Lock()
+	defer computing.mu.Unlock()
+	if computing.depths == nil {
+		computing.depths = make(map[uint64]int)
+	}
+	computing.depths[id]++
+	return id
+}
+
+// leaveCompute records the end of a compute started by enterCompute.
+func leaveCompute(id uint64) {
+	computing.mu.Lock()
+	defer computing.mu.Unlock()
+	if computing.depths[id]--; computing.depths[id] <= 0 {
+		delete(computing.depths, id)
+	}
+}
+
+// inCompute reports whether a compute is running on the calling goroutine.
+func inCompute() bool {
+	id := goroutineID()
+	computing.mu.Lock()
+	defer computing.mu.Unlock()
+	return computing.depths[id] > 0
+}
+
+// checkPure reports a write made by a compute function, see SetPurityCheck.
+func (s *signal[T]) checkPure() {
+	if purityCheck.Load() && inCompute() {
+		s.reportError(ErrImpureCompute)
+	}
+}
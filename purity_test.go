@@ -0,0 +1,59 @@
+package signals
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestPurityCheck_WriteInCompute verifies a Set from inside a compute is reported as ErrImpureCompute and still applied
+func TestPurityCheck_WriteInCompute(t *testing.T) {
+	SetPurityCheck(true)
+	defer SetPurityCheck(false)
+
+	var reported []any
+	log := NewWithOptions(0, Options[int]{
+		OnPanic: func(err any, _ []byte) { reported = append(reported, err) },
+	})
+	src := New(1)
+	doubled := Computed(func() int {
+		log.Update(func(n int) int { return n + 1 })
+		return src.Get() * 2
+	}, src)
+	defer doubled.Cleanup()
+
+	if got := doubled.Get(); got != 2 {
+		t.Errorf("doubled.Get() = %d, want 2", got)
+	}
+	if len(reported) != 1 {
+		t.Fatalf("reported %v, want one error", reported)
+	}
+	if err, ok := reported[0].(error); !ok || !errors.Is(err, ErrImpureCompute) {
+		t.Errorf("reported %v, want ErrImpureCompute", reported[0])
+	}
+	if got := log.Get(); got != 1 {
+		t.Errorf("log.Get() = %d, want the write applied", got)
+	}
+
+	log.Set(10) // Outside any compute
+	if len(reported) != 1 {
+		t.Errorf("reported %v after a write outside compute, want one error", reported)
+	}
+}
+
+// TestPurityCheck_Off verifies writes inside a compute go unreported while the check is off
+func TestPurityCheck_Off(t *testing.T) {
+	var reported []any
+	log := NewWithOptions(0, Options[int]{
+		OnPanic: func(err any, _ []byte) { reported = append(reported, err) },
+	})
+	c := Computed(func() int {
+		log.Set(1)
+		return 0
+	})
+	defer c.Cleanup()
+
+	c.Get()
+	if len(reported) != 0 {
+		t.Errorf("reported %v with the check off, want nothing", reported)
+	}
+}
@@ -0,0 +1,203 @@
+package signals
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// RateLimitOptions selects when RateLimit emits within a burst of changes.
+// If neither is set, Trailing is used.
+type RateLimitOptions struct {
+	// Leading emits the first change of a burst immediately.
+	Leading bool
+
+	// Trailing emits the last change of a burst once the source has been
+	// quiet for the window. It is skipped if that change was already
+	// emitted by Leading.
+	Trailing bool
+
+	// Clock measures the window. If nil, the real clock is used.
+	Clock Clock
+}
+
+// rateLimited is the internal implementation of RateLimit.
+type rateLimited[T any] struct {
+	window time.Duration
+	opts   RateLimitOptions
+	clock  Clock
+
+	// out holds the emitted values
+	out *signal[T]
+
+	// unsubscribe cancels the subscription to the source
+	unsubscribe Unsubscribe
+
+	// mu protects the burst state below
+	mu sync.Mutex
+
+	// timer closes the burst at deadline; a change before then moves deadline
+	timer    Timer
+	deadline time.Time
+	inBurst  bool
+
+	// pending is the latest change not yet emitted
+	pending    T
+	hasPending bool
+
+	// seq numbers emissions; applied (guarded by out.mu) drops stale ones
+	seq     uint64
+	applied uint64
+
+	stopped bool
+}
+
+// RateLimit derives a signal that follows source at most once per burst
+// edge. A burst is a run of changes less than window apart; it ends once
+// the source has been quiet for window.
+//
+// With Leading, the first change of a burst is emitted immediately. With
+// Trailing, the last change is emitted when the burst ends. Together they
+// emit both edges, and a burst of a single change is emitted only once.
+// Trailing alone is a debounce; Leading alone drops changes until quiet.
+//
+// Get returns the last emitted value (initially source's value). Call Stop
+// to unsubscribe from source and cancel a pending emission.
+//
+// Example:
+//
+//	query := signals.New("")
+//	search := signals.RateLimit(query.AsReadonly(), 300*time.Millisecond,
+//	    signals.RateLimitOptions{Trailing: true})
+//	defer search.Stop()
+//
+//	search.SubscribeForever(runSearch) // Once typing pauses for 300ms
+func RateLimit[T any](source ReadonlySignal[T], window time.Duration, opts RateLimitOptions) CombinedSignal[T] {
+	if !opts.Leading && !opts.Trailing {
+		opts.Trailing = true
+	}
+	r := &rateLimited[T]{
+		window: window,
+		opts:   opts,
+		clock:  clockOrReal(opts.Clock),
+		out:    newSignal(source.Get(), Options[T]{}),
+	}
+	r.unsubscribe = source.SubscribeForever(r.change)
+	return r
+}
+
+// change handles a source change.
+func (r *rateLimited[T]) change(value T) {
+	r.mu.Lock()
+	if r.stopped {
+		r.mu.Unlock()
+		return
+	}
+
+	r.deadline = r.clock.Now().Add(r.window)
+	leading := !r.inBurst
+	if leading {
+		r.inBurst = true
+		if r.opts.Trailing {
+			settling.add(1) // Until the burst ends
+		}
+		if r.timer == nil {
+			r.timer = r.clock.AfterFunc(r.window, r.expire)
+		} else {
+			r.timer.Reset(r.window)
+		}
+	} else {
+		r.timer.Reset(r.window)
+	}
+
+	if leading && r.opts.Leading {
+		r.hasPending = false
+		seq := r.nextSeq()
+		r.mu.Unlock()
+		r.emit(seq, value)
+		return
+	}
+	r.pending, r.hasPending = value, true
+	r.mu.Unlock()
+}
+
+// expire ends the burst and emits its last change if Trailing is set.
+func (r *rateLimited[T]) expire() {
+	r.mu.Lock()
+	if r.stopped || !r.inBurst || r.clock.Now().Before(r.deadline) {
+		r.mu.Unlock()
+		return // Stopped, or a later change moved the deadline; the timer fires again
+	}
+
+	r.inBurst = false
+	if r.opts.Trailing {
+		defer settling.done(1)
+	}
+	value, emit := r.pending, r.hasPending && r.opts.Trailing
+	var zero T
+	r.pending, r.hasPending = zero, false
+	if !emit {
+		r.mu.Unlock()
+		return
+	}
+	seq := r.nextSeq()
+	r.mu.Unlock()
+	r.emit(seq, value)
+}
+
+// nextSeq numbers an emission. Caller must hold mu.
+func (r *rateLimited[T]) nextSeq() uint64 {
+	r.seq++
+	return r.seq
+}
+
+// emit publishes value unless a later emission already landed.
+// Emissions happen outside mu, so subscribers may write to the source.
+func (r *rateLimited[T]) emit(seq uint64, value T) {
+	_ = r.out.apply(func(T) (T, bool) {
+		if seq < r.applied {
+			return value, false
+		}
+		r.applied = seq
+		return value, true
+	}, nil)
+}
+
+// Get returns the last emitted value.
+func (r *rateLimited[T]) Get() T {
+	return r.out.Get()
+}
+
+// Subscribe registers a callback that receives each emitted value.
+func (r *rateLimited[T]) Subscribe(ctx context.Context, fn func(T)) Unsubscribe {
+	return r.out.Subscribe(ctx, fn)
+}
+
+// SubscribeForever registers a callback that never auto-cancels.
+func (r *rateLimited[T]) SubscribeForever(fn func(T)) Unsubscribe {
+	return r.Subscribe(context.Background(), fn)
+}
+
+// subscribeDependent registers a downstream computed or effect as a dependent.
+func (r *rateLimited[T]) subscribeDependent(kind DependentKind, dependent reaction) Unsubscribe {
+	return r.out.subscribeDependent(kind, dependent)
+}
+
+// Stop unsubscribes from the source and drops a pending emission.
+func (r *rateLimited[T]) Stop() {
+	r.mu.Lock()
+	if r.stopped {
+		r.mu.Unlock()
+		return
+	}
+	r.stopped = true
+	if r.timer != nil {
+		r.timer.Stop()
+	}
+	if r.inBurst && r.opts.Trailing {
+		settling.done(1)
+	}
+	r.mu.Unlock()
+
+	r.unsubscribe()
+}
@@ -0,0 +1,130 @@
+package signals
+
+import (
+	"slices"
+	"sync"
+	"testing"
+	"time"
+)
+
+// recorder collects notifications from concurrent deliveries.
+type recorder[T any] struct {
+	mu     sync.Mutex
+	values []T
+}
+
+func (r *recorder[T]) record(v T) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.values = append(r.values, v)
+}
+
+func (r *recorder[T]) get() []T {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return slices.Clone(r.values)
+}
+
+// burst sets each value on sig in quick succession.
+func burst(sig Signal[int], values ...int) {
+	for _, v := range values {
+		sig.Set(v)
+	}
+}
+
+const rateWindow = 30 * time.Millisecond
+
+// TestRateLimit_LeadingOnly verifies only the first change of a burst is emitted
+func TestRateLimit_LeadingOnly(t *testing.T) {
+	src := New(0)
+	limited := RateLimit(src.AsReadonly(), rateWindow, RateLimitOptions{Leading: true})
+	defer limited.Stop()
+	rec := &recorder[int]{}
+	limited.SubscribeForever(rec.record)
+
+	burst(src, 1, 2, 3)
+	if got := limited.Get(); got != 1 {
+		t.Errorf("Get() during burst = %d, want 1", got)
+	}
+	time.Sleep(3 * rateWindow)
+
+	burst(src, 4)
+	time.Sleep(3 * rateWindow)
+
+	if got := rec.get(); !slices.Equal(got, []int{1, 4}) {
+		t.Errorf("emitted %v, want [1 4]", got)
+	}
+}
+
+// TestRateLimit_TrailingOnly verifies only the last change of a burst is emitted
+func TestRateLimit_TrailingOnly(t *testing.T) {
+	src := New(0)
+	limited := RateLimit(src.AsReadonly(), rateWindow, RateLimitOptions{Trailing: true})
+	defer limited.Stop()
+	rec := &recorder[int]{}
+	limited.SubscribeForever(rec.record)
+
+	burst(src, 1, 2, 3)
+	if got := limited.Get(); got != 0 {
+		t.Errorf("Get() during burst = %d, want 0", got)
+	}
+
+	waitFor(t, func() bool { return limited.Get() == 3 })
+	time.Sleep(2 * rateWindow)
+	if got := rec.get(); !slices.Equal(got, []int{3}) {
+		t.Errorf("emitted %v, want [3]", got)
+	}
+}
+
+// TestRateLimit_LeadingAndTrailing verifies both edges are emitted without duplicates
+func TestRateLimit_LeadingAndTrailing(t *testing.T) {
+	src := New(0)
+	limited := RateLimit(src.AsReadonly(), rateWindow, RateLimitOptions{Leading: true, Trailing: true})
+	defer limited.Stop()
+	rec := &recorder[int]{}
+	limited.SubscribeForever(rec.record)
+
+	burst(src, 1, 2, 3)
+	waitFor(t, func() bool { return limited.Get() == 3 })
+	time.Sleep(2 * rateWindow)
+
+	// A single change is emitted once, by the leading edge
+	burst(src, 4)
+	time.Sleep(3 * rateWindow)
+
+	if got := rec.get(); !slices.Equal(got, []int{1, 3, 4}) {
+		t.Errorf("emitted %v, want [1 3 4]", got)
+	}
+}
+
+// TestRateLimit_DefaultsToTrailing verifies empty options behave as Trailing
+func TestRateLimit_DefaultsToTrailing(t *testing.T) {
+	src := New(0)
+	limited := RateLimit(src.AsReadonly(), rateWindow, RateLimitOptions{})
+	defer limited.Stop()
+
+	burst(src, 1, 2)
+	if got := limited.Get(); got != 0 {
+		t.Errorf("Get() during burst = %d, want 0", got)
+	}
+	waitFor(t, func() bool { return limited.Get() == 2 })
+}
+
+// TestRateLimit_Stop verifies Stop cancels a pending trailing emission
+func TestRateLimit_Stop(t *testing.T) {
+	src := New(0)
+	limited := RateLimit(src.AsReadonly(), rateWindow, RateLimitOptions{Trailing: true})
+
+	src.Set(1)
+	limited.Stop()
+	limited.Stop() // Safe to call twice
+	src.Set(2)
+	time.Sleep(3 * rateWindow)
+
+	if got := limited.Get(); got != 0 {
+		t.Errorf("Get() after Stop = %d, want 0", got)
+	}
+	if got := subscriberCount(src); got != 0 {
+		t.Errorf("source subscriber count = %d, want 0", got)
+	}
+}
@@ -6,7 +6,12 @@ import "context"
 // It implements ReadonlySignal by delegating to the source Signal,
 // but does not expose Set/Update methods.
 type readonlySignal[T any] struct {
-	source Signal[T]
+	dependencyView[T]
+}
+
+// newReadonly returns a read-only view of s.
+func newReadonly[T any](s Signal[T]) *readonlySignal[T] {
+	return &readonlySignal[T]{dependencyView[T]{source: s}}
 }
 
 // Get returns the current value from the source signal.
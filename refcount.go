@@ -0,0 +1,69 @@
+package signals
+
+// refCounted is a signal whose producer runs only while it has subscribers.
+type refCounted[T any] struct {
+	*signal[T]
+
+	onActivate func(set func(T)) (stop func())
+
+	// active is set while the producer runs; stop ends it. Only accessed
+	// by onCount, which the count signal never runs concurrently.
+	active bool
+	stop   func()
+}
+
+// RefCounted creates a writable signal fed by a producer that runs only
+// while the signal has subscribers: onActivate is called when the first
+// subscriber arrives, to start pushing values with set, and the stop it
+// returns (which may be nil) runs when the last one leaves or on Close.
+// A later subscriber activates it again.
+//
+// This is the share and ref-count pattern for expensive upstreams such as
+// polling or sockets. Get works while inactive and returns the last value
+// pushed (initially initial); computeds and effects don't count as
+// subscribers. onActivate and stop run on the goroutine that subscribed or
+// unsubscribed, or one it is racing with, never concurrently.
+//
+// Example:
+//
+//	quotes := signals.RefCounted(Quote{}, func(set func(Quote)) func() {
+//	    conn := feed.Dial("EURUSD")
+//	    go func() {
+//	        for q := range conn.Quotes() {
+//	            set(q)
+//	        }
+//	    }()
+//	    return conn.Close
+//	})
+func RefCounted[T any](initial T, onActivate func(set func(T)) (stop func())) Signal[T] {
+	r := &refCounted[T]{signal: newSignal(initial, Options[T]{}), onActivate: onActivate}
+	r.SubscriberCountSignal().SubscribeForever(r.onCount)
+	return r
+}
+
+// onCount starts or stops the producer as the subscriber count leaves or
+// reaches zero.
+func (r *refCounted[T]) onCount(n int) {
+	switch {
+	case n > 0 && !r.active:
+		r.stop = r.onActivate(r.signal.Set)
+		r.active = true
+	case n == 0 && r.active:
+		r.active = false
+		if stop := r.stop; stop != nil {
+			r.stop = nil
+			stop()
+		}
+	}
+}
+
+// AsReadonly returns a read-only view of the signal.
+func (r *refCounted[T]) AsReadonly() ReadonlySignal[T] {
+	return newReadonly[T](r)
+}
+
+// Fork returns an independent ref-counted signal with the current value
+// and the same producer, inactive until subscribed to.
+func (r *refCounted[T]) Fork() Signal[T] {
+	return RefCounted(r.Get(), r.onActivate)
+}
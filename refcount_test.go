@@ -0,0 +1,63 @@
+package signals
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+)
+
+// TestRefCounted_Lifecycle verifies onActivate runs on the first subscriber, stop on the last, and re-activation works
+func TestRefCounted_Lifecycle(t *testing.T) {
+	var activations int
+	var stops atomic.Int32
+	sig := RefCounted(0, func(set func(int)) func() {
+		activations++
+		set(activations * 10)
+		return func() { stops.Add(1) }
+	})
+
+	if activations != 0 || sig.Get() != 0 {
+		t.Fatalf("activated %d times before any subscriber, Get() = %d", activations, sig.Get())
+	}
+
+	var got int
+	unsubA := sig.SubscribeForever(func(v int) { got = v })
+	unsubB := sig.SubscribeForever(func(int) {})
+	if activations != 1 || got != 10 {
+		t.Errorf("after subscribing: %d activations, subscriber got %d, want 1 and 10", activations, got)
+	}
+
+	unsubA()
+	if stops.Load() != 0 {
+		t.Error("stopped with a subscriber left")
+	}
+	unsubB()
+	if n := stops.Load(); n != 1 {
+		t.Errorf("%d stops after the last unsubscribe, want 1", n)
+	}
+	if sig.Get() != 10 {
+		t.Errorf("Get() = %d while inactive, want the last value 10", sig.Get())
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	sig.Subscribe(ctx, func(int) {})
+	if activations != 2 || sig.Get() != 20 {
+		t.Errorf("re-subscribing: %d activations, Get() = %d, want 2 and 20", activations, sig.Get())
+	}
+	cancel()
+	waitFor(t, func() bool { return stops.Load() == 2 }) // Stopped by the cancellation
+}
+
+// TestRefCounted_Close verifies Close stops an active producer
+func TestRefCounted_Close(t *testing.T) {
+	stopped := false
+	sig := RefCounted("", func(func(string)) func() {
+		return func() { stopped = true }
+	})
+	sig.SubscribeForever(func(string) {})
+	sig.Close()
+
+	if !stopped {
+		t.Error("producer still running after Close")
+	}
+}
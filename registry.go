@@ -0,0 +1,67 @@
+package signals
+
+import (
+	"slices"
+	"sync"
+)
+
+// Registry maps keys to shared signals, so services can fetch them by name
+// instead of being wired together by hand. Signals of any type can be
+// registered side by side; Get only returns a signal under its own type.
+//
+// The zero value is an empty registry ready to use. A Registry is safe for
+// concurrent use. Register and Get are functions rather than methods
+// because Go methods can't have type parameters.
+//
+// Example:
+//
+//	var reg signals.Registry
+//	signals.Register(&reg, "cart.total", total)
+//
+//	// Elsewhere
+//	if total, ok := signals.Get[int](&reg, "cart.total"); ok {
+//	    total.SubscribeForever(updateBadge)
+//	}
+type Registry struct {
+	mu      sync.RWMutex
+	signals map[string]any
+}
+
+// Register stores s under key, replacing any signal registered under it.
+func Register[T any](r *Registry, key string, s Signal[T]) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.signals == nil {
+		r.signals = make(map[string]any)
+	}
+	r.signals[key] = s
+}
+
+// Get returns the signal registered under key. It reports false if there is
+// none, or if it isn't a Signal[T].
+func Get[T any](r *Registry, key string) (Signal[T], bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	s, ok := r.signals[key].(Signal[T])
+	return s, ok
+}
+
+// Unregister removes the signal registered under key, if any. The signal
+// itself is left untouched.
+func (r *Registry) Unregister(key string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.signals, key)
+}
+
+// Keys returns the registered keys, sorted.
+func (r *Registry) Keys() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	keys := make([]string, 0, len(r.signals))
+	for key := range r.signals {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
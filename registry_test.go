@@ -0,0 +1,66 @@
+package signals
+
+import (
+	"slices"
+	"sync"
+	"testing"
+)
+
+// TestRegistry_TypedLookup verifies signals of different types are returned under their own type
+func TestRegistry_TypedLookup(t *testing.T) {
+	var reg Registry
+	count, name := New(1), New("alice")
+	Register(&reg, "count", count)
+	Register(&reg, "name", name)
+
+	got, ok := Get[int](&reg, "count")
+	if !ok || got != count {
+		t.Fatalf("Get[int](count) = %v, %v; want the registered signal", got, ok)
+	}
+	got.Set(2)
+	if count.Get() != 2 {
+		t.Error("retrieved signal is not the registered one")
+	}
+	if s, ok := Get[string](&reg, "name"); !ok || s.Get() != "alice" {
+		t.Errorf("Get[string](name) = %v, %v; want alice", s, ok)
+	}
+	if keys := reg.Keys(); !slices.Equal(keys, []string{"count", "name"}) {
+		t.Errorf("Keys() = %v, want [count name]", keys)
+	}
+}
+
+// TestRegistry_Mismatch verifies wrong types and missing keys report false without panicking
+func TestRegistry_Mismatch(t *testing.T) {
+	var reg Registry
+	if _, ok := Get[int](&reg, "missing"); ok {
+		t.Error("Get on an empty registry succeeded")
+	}
+
+	Register(&reg, "count", New(1))
+	if s, ok := Get[string](&reg, "count"); ok || s != nil {
+		t.Errorf("Get[string] of a Signal[int] = %v, %v; want nil, false", s, ok)
+	}
+	if _, ok := Get[int64](&reg, "count"); ok {
+		t.Error("Get[int64] of a Signal[int] succeeded")
+	}
+
+	reg.Unregister("count")
+	if _, ok := Get[int](&reg, "count"); ok {
+		t.Error("Get after Unregister succeeded")
+	}
+}
+
+// TestRegistry_Concurrent verifies concurrent registration and lookup are safe
+func TestRegistry_Concurrent(t *testing.T) {
+	var reg Registry
+	var wg sync.WaitGroup
+	for i := range 20 {
+		wg.Go(func() {
+			Register(&reg, "shared", New(i))
+			if _, ok := Get[int](&reg, "shared"); !ok {
+				t.Error("Get[int](shared) failed after Register")
+			}
+		})
+	}
+	wg.Wait()
+}
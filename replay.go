@@ -0,0 +1,166 @@
+package signals
+
+import (
+	"context"
+	"sync"
+)
+
+// replaySignal is a signal that remembers its last n values and replays
+// them to new subscribers before live delivery.
+type replaySignal[T any] struct {
+	*signal[T]
+
+	// ring holds the last values written, oldest at start.
+	// Guarded by signal.mu: it is appended to while each write is committed.
+	ring  []T
+	start int
+	size  int
+}
+
+// NewReplay creates a writable signal with event semantics: each Set is an
+// event, and the last n events are kept in a ring buffer. A new subscriber
+// first receives the buffered events in order, then live events.
+//
+// Get returns the most recent value (the zero value before the first Set).
+// With n <= 0 nothing is buffered and the signal behaves like New.
+//
+// Replay and live delivery are consistent under concurrency: every event is
+// either in the replayed history or delivered live, never both or neither.
+//
+// Example:
+//
+//	events := signals.NewReplay[string](3)
+//	for _, e := range []string{"a", "b", "c", "d"} {
+//	    events.Set(e)
+//	}
+//	events.SubscribeForever(func(e string) {
+//	    fmt.Println(e) // b, c, d, then live events
+//	})
+func NewReplay[T any](n int) Signal[T] {
+	var zero T
+	if n <= 0 {
+		return New(zero)
+	}
+	return newReplay(zero, make([]T, n))
+}
+
+// newReplay creates a replay signal using ring as its buffer.
+func newReplay[T any](initial T, ring []T) *replaySignal[T] {
+	r := &replaySignal[T]{ring: ring}
+	r.signal = newSignal(initial, Options[T]{
+		// Interceptors run under the signal lock as each write commits,
+		// which keeps the buffer in step with subscriber registration
+		Interceptors: []func(old, new T) T{r.record},
+	})
+	return r
+}
+
+// record appends a committed value to the ring. Caller must hold signal.mu.
+func (r *replaySignal[T]) record(_, value T) T {
+	end := (r.start + r.size) % len(r.ring)
+	r.ring[end] = value
+	if r.size < len(r.ring) {
+		r.size++
+	} else {
+		r.start = (r.start + 1) % len(r.ring)
+	}
+	return value
+}
+
+// history returns the buffered values, oldest first. Caller must hold signal.mu.
+func (r *replaySignal[T]) history() []T {
+	values := make([]T, r.size)
+	for i := range values {
+		values[i] = r.ring[(r.start+i)%len(r.ring)]
+	}
+	return values
+}
+
+// Subscribe replays the buffered values to fn, then delivers live values.
+func (r *replaySignal[T]) Subscribe(ctx context.Context, fn func(T)) Unsubscribe {
+	sub := &replaySubscriber[T]{fn: fn, replaying: true}
+
+	// Snapshot and register atomically with respect to writes
+	r.mu.Lock()
+	history := r.history()
+	id, err := r.addSubscriberLocked(sub.deliver)
+	r.mu.Unlock()
+	if err != nil {
+		r.rejectSubscriber(err)
+		return func() {}
+	}
+	r.publishSubscriberCount()
+	r.checkDuplicate(id, fn)
+
+	unsub := trackLeak(r.watchSubscription(ctx, id), r.subscribed(id))
+	sub.replay(history, r.signal)
+	return unsub
+}
+
+// SubscribeForever registers a replaying callback that never auto-cancels.
+func (r *replaySignal[T]) SubscribeForever(fn func(T)) Unsubscribe {
+	return r.Subscribe(context.Background(), fn)
+}
+
+// AsReadonly returns a read-only view that keeps replay semantics.
+func (r *replaySignal[T]) AsReadonly() ReadonlySignal[T] {
+	return newReadonly[T](r)
+}
+
+// Fork returns an independent replay signal with the same value and history.
+func (r *replaySignal[T]) Fork() Signal[T] {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	fork := newReplay(r.load(), make([]T, len(r.ring)))
+	for _, v := range r.history() {
+		fork.record(v, v)
+	}
+	return fork
+}
+
+// replaySubscriber holds live values back while history is being replayed.
+type replaySubscriber[T any] struct {
+	fn func(T)
+
+	// mu protects replaying and pending
+	mu        sync.Mutex
+	replaying bool
+	pending   []T
+}
+
+// deliver passes a live value to fn, or defers it until replay completes.
+func (s *replaySubscriber[T]) deliver(value T) {
+	s.mu.Lock()
+	if s.replaying {
+		s.pending = append(s.pending, value)
+		s.mu.Unlock()
+		return
+	}
+	s.mu.Unlock()
+	s.fn(value)
+}
+
+// replay delivers history, then live values that arrived meanwhile, then
+// switches to direct delivery. Replayed calls get sig's panic recovery.
+func (s *replaySubscriber[T]) replay(history []T, sig *signal[T]) {
+	callbacks := []func(T){s.fn}
+	for _, v := range history {
+		sig.notifySubscribers(callbacks, v, nil)
+	}
+	for {
+		s.mu.Lock()
+		pending := s.pending
+		s.pending = nil
+		if len(pending) == 0 {
+			s.replaying = false
+			s.mu.Unlock()
+			return
+		}
+		s.mu.Unlock()
+
+		for _, v := range pending {
+			sig.notifySubscribers(callbacks, v, nil)
+		}
+	}
+}
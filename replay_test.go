@@ -0,0 +1,128 @@
+package signals
+
+import (
+	"slices"
+	"sync"
+	"testing"
+)
+
+// TestReplay_LateSubscriberReplaysLastN verifies a late subscriber gets the
+// last n values in order, then live values
+func TestReplay_LateSubscriberReplaysLastN(t *testing.T) {
+	events := NewReplay[int](3)
+	for i := 1; i <= 5; i++ {
+		events.Set(i)
+	}
+
+	var got []int
+	unsub := events.SubscribeForever(func(v int) { got = append(got, v) })
+	defer unsub()
+
+	if want := []int{3, 4, 5}; !slices.Equal(got, want) {
+		t.Errorf("replayed %v, want %v", got, want)
+	}
+
+	events.Set(6)
+	if want := []int{3, 4, 5, 6}; !slices.Equal(got, want) {
+		t.Errorf("after live Set got %v, want %v", got, want)
+	}
+	if v := events.Get(); v != 6 {
+		t.Errorf("Get() = %d, want 6", v)
+	}
+}
+
+// TestReplay_ZeroBehavesLikeSignal verifies n=0 replays nothing
+func TestReplay_ZeroBehavesLikeSignal(t *testing.T) {
+	events := NewReplay[string](0)
+	events.Set("a")
+
+	var got []string
+	unsub := events.SubscribeForever(func(v string) { got = append(got, v) })
+	defer unsub()
+
+	if len(got) != 0 {
+		t.Errorf("n=0 replayed %v, want nothing", got)
+	}
+	events.Set("b")
+	if !slices.Equal(got, []string{"b"}) {
+		t.Errorf("got %v, want [b]", got)
+	}
+}
+
+// TestReplay_ReadonlyAndFork verifies replay survives AsReadonly and Fork copies history
+func TestReplay_ReadonlyAndFork(t *testing.T) {
+	events := NewReplay[int](2)
+	events.Set(1)
+	events.Set(2)
+
+	var viaReadonly []int
+	defer events.AsReadonly().SubscribeForever(func(v int) { viaReadonly = append(viaReadonly, v) })()
+	if !slices.Equal(viaReadonly, []int{1, 2}) {
+		t.Errorf("readonly view replayed %v, want [1 2]", viaReadonly)
+	}
+
+	fork := events.Fork()
+	fork.Set(3)
+	events.Set(10)
+
+	var forked []int
+	defer fork.SubscribeForever(func(v int) { forked = append(forked, v) })()
+	if !slices.Equal(forked, []int{2, 3}) {
+		t.Errorf("fork replayed %v, want [2 3]", forked)
+	}
+}
+
+// TestReplay_SubscribeDuringEmit verifies a subscriber joining mid-stream
+// sees every event exactly once, in order
+func TestReplay_SubscribeDuringEmit(t *testing.T) {
+	const total = 2000
+	events := NewReplay[int](total)
+
+	var wg sync.WaitGroup
+	started := make(chan struct{})
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 1; i <= total; i++ {
+			if i == total/4 {
+				close(started)
+			}
+			events.Set(i)
+		}
+	}()
+
+	<-started
+	var mu sync.Mutex
+	var got []int
+	unsub := events.SubscribeForever(func(v int) {
+		mu.Lock()
+		got = append(got, v)
+		mu.Unlock()
+	})
+	defer unsub()
+	wg.Wait()
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(got) != total {
+		t.Fatalf("received %d events, want %d", len(got), total)
+	}
+	for i, v := range got {
+		if v != i+1 {
+			t.Fatalf("event %d = %d, want %d (missed, duplicated, or reordered)", i, v, i+1)
+		}
+	}
+}
+
+// TestReplay_SetWithMeta verifies writes with meta are recorded for replay like plain writes
+func TestReplay_SetWithMeta(t *testing.T) {
+	r := NewReplay[int](2)
+	r.(MetaSetter[int]).SetWithMeta(1, "a")
+	r.Set(2)
+
+	var got []int
+	r.SubscribeForever(func(v int) { got = append(got, v) })
+	if want := []int{1, 2}; !slices.Equal(got, want) {
+		t.Errorf("replayed %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,86 @@
+package signals
+
+import (
+	"context"
+	"sync"
+)
+
+// settling counts the deferred work Settle waits for.
+var settling pendingWork
+
+// Settle blocks until all deferred reactive work has completed, or ctx is
+// done, in which case it returns ctx's error. It waits for:
+//   - runs of debounced effects (EffectOptions.Debounce)
+//   - runs queued to EffectActor mailboxes
+//   - delayed notifications of computeds (Options.CoalesceWindow)
+//   - late results of timed-out computes (Options.ComputeTimeout)
+//   - background recomputes of ComputedAsync
+//   - pending reverts of NewAutoReset signals
+//   - trailing emissions of RateLimit (and Pipeline.Debounce)
+//
+// Work scheduled by this work, e.g., a debounced effect writing a signal
+// another debounced effect depends on, is waited for too. Settle covers the
+// whole process, not a single signal, so work started concurrently by other
+// goroutines may delay it. Work timed by a FakeClock stays pending until the
+// clock is advanced past it.
+//
+// Use it in tests instead of sleeping until asynchronous reactions are done.
+//
+// Example:
+//
+//	query.Set("golang")
+//	if err := signals.Settle(ctx); err != nil {
+//	    t.Fatal(err)
+//	}
+//	// The debounced search effect has run
+func Settle(ctx context.Context) error {
+	return settling.wait(ctx)
+}
+
+// pendingWork counts work in flight and wakes waiters once there is none.
+type pendingWork struct {
+	mu sync.Mutex
+	n  int
+
+	// idle is closed when n drops to zero (nil until someone waits)
+	idle chan struct{}
+}
+
+// add records n units of work scheduled.
+func (p *pendingWork) add(n int) {
+	p.mu.Lock()
+	p.n += n
+	p.mu.Unlock()
+}
+
+// done records n units of work completed or canceled.
+func (p *pendingWork) done(n int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.n -= n
+	if p.n == 0 && p.idle != nil {
+		close(p.idle)
+		p.idle = nil
+	}
+}
+
+// wait blocks until no work is pending or ctx is done.
+func (p *pendingWork) wait(ctx context.Context) error {
+	p.mu.Lock()
+	if p.n == 0 {
+		p.mu.Unlock()
+		return nil
+	}
+	if p.idle == nil {
+		p.idle = make(chan struct{})
+	}
+	idle := p.idle
+	p.mu.Unlock()
+
+	select {
+	case <-idle:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
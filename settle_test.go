@@ -0,0 +1,139 @@
+package signals
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// settleCtx returns a context bounding a Settle call in tests.
+func settleCtx(t *testing.T) context.Context {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+	return ctx
+}
+
+// TestSettle_DebouncedEffect verifies Settle returns only after a debounced effect has run
+func TestSettle_DebouncedEffect(t *testing.T) {
+	count := New(0)
+	var seen atomic.Int64
+	eff := EffectWithOptions(func() func() {
+		seen.Store(int64(count.Get()))
+		return nil
+	}, EffectOptions{Debounce: 30 * time.Millisecond}, count.AsReadonly())
+	defer eff.Stop()
+
+	count.Set(1)
+	count.Set(2)
+	if err := Settle(settleCtx(t)); err != nil {
+		t.Fatalf("Settle() = %v, want nil", err)
+	}
+	if got := seen.Load(); got != 2 {
+		t.Errorf("effect saw %d after Settle, want 2", got)
+	}
+}
+
+// TestSettle_Chained verifies Settle waits for work scheduled by deferred work
+func TestSettle_Chained(t *testing.T) {
+	a, b := New(0), New(0)
+	first := EffectActor(func() { b.Set(a.Get() + 1) }, 1, a.AsReadonly())
+	defer first.Stop()
+
+	var seen atomic.Int64
+	second := EffectWithOptions(func() func() {
+		seen.Store(int64(b.Get()))
+		return nil
+	}, EffectOptions{Debounce: 20 * time.Millisecond}, b.AsReadonly())
+	defer second.Stop()
+
+	a.Set(10)
+	if err := Settle(settleCtx(t)); err != nil {
+		t.Fatalf("Settle() = %v, want nil", err)
+	}
+	if got := seen.Load(); got != 11 {
+		t.Errorf("second effect saw %d after Settle, want 11", got)
+	}
+}
+
+// TestSettle_CoalescedAndRateLimited verifies Settle waits for delayed computed and RateLimit notifications
+func TestSettle_CoalescedAndRateLimited(t *testing.T) {
+	src := New(0)
+	doubled := ComputedWithOptions(func() int { return src.Get() * 2 },
+		Options[int]{CoalesceWindow: 20 * time.Millisecond}, src.AsReadonly())
+	var notified atomic.Int64
+	doubled.SubscribeForever(func(v int) { notified.Store(int64(v)) })
+	doubled.Get()
+
+	limited := RateLimit(src.AsReadonly(), 20*time.Millisecond, RateLimitOptions{Trailing: true})
+	defer limited.Stop()
+	var emitted atomic.Int64
+	limited.SubscribeForever(func(v int) { emitted.Store(int64(v)) })
+
+	src.Set(1)
+	src.Set(2)
+	if err := Settle(settleCtx(t)); err != nil {
+		t.Fatalf("Settle() = %v, want nil", err)
+	}
+	if got := notified.Load(); got != 4 {
+		t.Errorf("coalesced computed notified %d after Settle, want 4", got)
+	}
+	if got := emitted.Load(); got != 2 {
+		t.Errorf("RateLimit emitted %d after Settle, want 2", got)
+	}
+}
+
+// TestSettle_AsyncAndAutoReset verifies Settle waits for ComputedAsync recomputes and AutoReset reverts
+func TestSettle_AsyncAndAutoReset(t *testing.T) {
+	src := New(1)
+	slow := ComputedAsync(func() int {
+		v := src.Get()
+		time.Sleep(20 * time.Millisecond)
+		return v * 10
+	}, src.AsReadonly())
+	defer slow.Cleanup()
+
+	status := NewAutoReset("", "idle", 20*time.Millisecond)
+	defer status.Close()
+	var reverted atomic.Bool
+	status.SubscribeForever(func(s string) { reverted.Store(s == "idle") })
+
+	src.Set(2)
+	status.Set("saved")
+	if err := Settle(settleCtx(t)); err != nil {
+		t.Fatalf("Settle() = %v, want nil", err)
+	}
+	if got := slow.Get(); got != 20 {
+		t.Errorf("ComputedAsync Get() = %d after Settle, want 20", got)
+	}
+	if !reverted.Load() {
+		t.Errorf("AutoReset still %q after Settle, want reverted and delivered", status.Get())
+	}
+}
+
+// TestSettle_ContextDone verifies Settle gives up when ctx is done, and Stop cancels the pending work
+func TestSettle_ContextDone(t *testing.T) {
+	count := New(0)
+	var runs atomic.Int32
+	eff := EffectWithOptions(func() func() {
+		runs.Add(1)
+		return nil
+	}, EffectOptions{Debounce: time.Hour}, count.AsReadonly())
+	count.Set(1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := Settle(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Settle() = %v, want context.DeadlineExceeded", err)
+	}
+
+	eff.Stop()
+	if err := Settle(settleCtx(t)); err != nil {
+		t.Errorf("Settle() after Stop = %v, want nil", err)
+	}
+	if got := runs.Load(); got != 1 {
+		t.Errorf("effect ran %d times, want 1 (initial run only)", got)
+	}
+}
@@ -0,0 +1,52 @@
+package signals
+
+import "context"
+
+// sharedSignal is the signal returned by Share.
+type sharedSignal[T any] struct {
+	dependencyView[T]
+
+	// fanout delivers the upstream subscription's values to subscribers,
+	// holding that subscription only while it has subscribers
+	fanout Signal[T]
+}
+
+// Share returns a view of source whose subscribers all share a single
+// subscription to source: N subscribers cost source one. The upstream
+// subscription is made when the first subscriber arrives and dropped when
+// the last one leaves, so an idle view costs source nothing.
+//
+// Use it when many operators or components subscribe to the same
+// upstream, e.g., one whose delivery is expensive. Get reads source
+// directly, so it is fresh even while nobody is subscribed. Computeds and
+// effects depending on the view register with source itself, like for
+// AsReadonly views, and don't count as subscribers.
+//
+// Example:
+//
+//	prices := signals.Share(feed.AsReadonly())
+//	for _, w := range widgets {
+//	    unsubs = append(unsubs, prices.SubscribeForever(w.Render)) // One feed subscription
+//	}
+func Share[T any](source ReadonlySignal[T]) ReadonlySignal[T] {
+	var zero T
+	fanout := RefCounted(zero, func(set func(T)) func() {
+		return source.SubscribeForever(set)
+	})
+	return &sharedSignal[T]{dependencyView: dependencyView[T]{source: source}, fanout: fanout}
+}
+
+// Get returns the source's value.
+func (s *sharedSignal[T]) Get() T {
+	return s.source.Get()
+}
+
+// Subscribe registers fn on the shared upstream subscription.
+func (s *sharedSignal[T]) Subscribe(ctx context.Context, fn func(T)) Unsubscribe {
+	return s.fanout.Subscribe(ctx, fn)
+}
+
+// SubscribeForever is like Subscribe, never auto-canceling.
+func (s *sharedSignal[T]) SubscribeForever(fn func(T)) Unsubscribe {
+	return s.fanout.SubscribeForever(fn)
+}
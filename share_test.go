@@ -0,0 +1,78 @@
+package signals
+
+import (
+	"context"
+	"testing"
+)
+
+// TestShare_OneUpstreamSubscription verifies many subscribers of a shared view cost the source one subscription
+func TestShare_OneUpstreamSubscription(t *testing.T) {
+	src := New(0)
+	shared := Share(src.AsReadonly())
+
+	const n = 5
+	calls := make([]int, n)
+	unsubs := make([]Unsubscribe, n)
+	for i := range n {
+		unsubs[i] = shared.SubscribeForever(func(int) { calls[i]++ })
+	}
+	if got := subscriberCount(src); got != 1 {
+		t.Errorf("source has %d subscribers for %d downstream, want 1", got, n)
+	}
+
+	src.Set(1)
+	for i, c := range calls {
+		if c != 1 {
+			t.Errorf("subscriber %d called %d times, want 1", i, c)
+		}
+	}
+
+	for _, unsub := range unsubs[1:] {
+		unsub()
+	}
+	if got := subscriberCount(src); got != 1 {
+		t.Errorf("source has %d subscribers with one downstream left, want 1", got)
+	}
+	unsubs[0]()
+	if got := subscriberCount(src); got != 0 {
+		t.Errorf("source has %d subscribers after the last downstream left, want 0", got)
+	}
+}
+
+// TestShare_Reconnects verifies Get stays fresh while disconnected and a context-bound subscriber connects and disconnects the view
+func TestShare_Reconnects(t *testing.T) {
+	src := New("a")
+	shared := Share(src.AsReadonly())
+
+	src.Set("b")
+	if got := shared.Get(); got != "b" {
+		t.Errorf("Get() = %q while disconnected, want b", got)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var got []string
+	shared.Subscribe(ctx, func(v string) { got = append(got, v) })
+	src.Set("c")
+	cancel()
+	waitFor(t, func() bool { return subscriberCount(src) == 0 })
+
+	if len(got) != 1 || got[0] != "c" {
+		t.Errorf("subscriber saw %q, want [c]", got)
+	}
+}
+
+// TestShare_AsDependency verifies computeds reading a shared view track the source directly
+func TestShare_AsDependency(t *testing.T) {
+	src := New(2)
+	shared := Share(src.AsReadonly())
+	doubled := Computed(func() int { return shared.Get() * 2 }, shared)
+	defer doubled.Cleanup()
+
+	src.Set(5)
+	if got := doubled.Get(); got != 10 {
+		t.Errorf("doubled.Get() = %d, want 10", got)
+	}
+	if d := src.Dependents(); d.Computed != 1 {
+		t.Errorf("source Dependents() = %+v, want one computed", d)
+	}
+}
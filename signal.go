@@ -1,21 +1,78 @@
 package signals
 
 import (
+	"cmp"
 	"context"
-	"log"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
 	"runtime/debug"
+	"slices"
 	"sync"
 	"sync/atomic"
+	"time"
+	"unsafe"
 )
 
+// ErrNotifyLoop is reported when subscribers keep writing to the signal they
+// are being notified by, exceeding maxNotifyFollowUps queued notifications.
+var ErrNotifyLoop = errors.New("signals: notification loop exceeded follow-up limit")
+
+// ErrSignalClosed is returned by writes to a signal after Close.
+var ErrSignalClosed = errors.New("signals: signal is closed")
+
+// ErrSubscriberLimit is reported to Options.OnLimit when a subscription is
+// rejected because the signal has Options.MaxSubscribers subscribers.
+var ErrSubscriberLimit = errors.New("signals: subscriber limit reached")
+
+// maxNotifyFollowUps bounds how many queued notifications a single delivery
+// drains before giving up on a runaway write-back loop.
+const maxNotifyFollowUps = 1000
+
+// PanicError is a recovered panic returned as an error, e.g. by SetSafe for
+// panicking subscribers.
+type PanicError struct {
+	// Value is the value passed to panic
+	Value any
+
+	// Stack is the stack trace of the panicking goroutine
+	Stack []byte
+}
+
+// Error describes the recovered panic.
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("signals: recovered panic: %v", e.Value)
+}
+
+// Unwrap returns the panic value if it is an error.
+func (e *PanicError) Unwrap() error {
+	err, _ := e.Value.(error)
+	return err
+}
+
+// queuedNotification is a notification deferred until the current delivery completes.
+type queuedNotification[T any] struct {
+	callbacks *[]func(T)
+	value     T
+	meta      any
+}
+
 // signal is the internal implementation of Signal[T].
 // It uses map-based subscriber storage for O(1) unsubscribe operations.
 type signal[T any] struct {
-	// value is the current value of the signal
+	// value is the current value of the signal (unused with lockFreeReads)
 	value T
 
-	// equal is an optional custom equality function
-	equal EqualFunc[T]
+	// hot holds the current value when lockFreeReads is set
+	hot atomic.Pointer[T]
+
+	// lockFreeReads selects hot over value (Options.LockFreeReads)
+	lockFreeReads bool
+
+	// equal points to the optional custom equality function. Atomic so the
+	// write fast path reads it without locking; swapped by SetEqual.
+	equal atomic.Pointer[EqualFunc[T]]
 
 	// subscribers maps unique IDs to callback functions
 	// Using map instead of slice provides O(1) delete without index corruption
@@ -24,15 +81,102 @@ type signal[T any] struct {
 	// nextID is the incrementing unique ID for subscribers
 	nextID uint64
 
-	// mu protects value, subscribers, and nextID
+	// priorities holds the priority of each SubscribeWithPriority subscriber
+	// (nil until the first). Once set, snapshots are ordered by priority.
+	priorities map[uint64]int
+
+	// reactions maps subscriber IDs to the computeds and effects depending
+	// on the signal, kept apart from subscribers so they are never queued
+	reactions map[uint64]reaction
+
+	// dependents records the kind of each reaction, keyed by subscriber ID
+	dependents map[uint64]DependentKind
+
+	// notifying is set while a goroutine is delivering notifications
+	notifying bool
+
+	// queued holds notifications from writes made during delivery
+	// (e.g., a subscriber writing back to this signal), in write order
+	queued []queuedNotification[T]
+
+	// deliveryMeta is the SetWithMeta metadata of the notification being
+	// delivered, read by SubscribeMeta subscribers. Only accessed by the
+	// goroutine delivering.
+	deliveryMeta any
+
+	// callbackIDs holds the identity of each subscriber's callback, for
+	// the duplicate check (nil unless SetDuplicateSubscriptionCheck is on)
+	callbackIDs map[uint64]unsafe.Pointer
+
+	// watchers stops the context.AfterFunc of each context-bound subscription
+	watchers map[uint64]func()
+
+	// closed is set by Close; later writes are no-ops.
+	// Written under mu, read lock-free on the write fast path.
+	closed atomic.Bool
+
+	// mu protects value (and serializes writes to hot), subscribers, reactions,
+	// dependents, callbackIDs, watchers, nextID, priorities, notifying, and queued
 	mu sync.RWMutex
 
 	// onPanic is an optional custom panic handler
 	onPanic func(any, []byte)
 
+	// logger is the fallback for panics without onPanic (nil means package log)
+	logger *slog.Logger
+
+	// rePanicOn optionally selects subscriber panics to raise again
+	rePanicOn func(r any) bool
+
+	// onTiming optionally receives notification durations (Options.OnTiming)
+	onTiming func(op string, d time.Duration)
+
+	// history records stored values (nil unless Options.RecordHistory)
+	history *history[T]
+
+	// maxSubscribers caps len(subscribers) if positive (Options.MaxSubscribers)
+	maxSubscribers int
+
+	// onLimit optionally receives subscriptions refused at maxSubscribers
+	onLimit func(err error)
+
+	// deliveries records what each subscriber last received
+	// (nil unless Options.TrackDeliveries)
+	deliveries *deliveries[T]
+
+	// slow times subscribers (nil unless Options.SlowSubscriberThreshold)
+	slow *slowWatch
+
+	// freshness refreshes old values on Get (nil unless Options.MaxAge)
+	freshness *freshness[T]
+
+	// strong holds readers off writes until subscribers are notified
+	// (Options.StrongConsistency), using consistency
+	strong      bool
+	consistency sync.RWMutex
+
+	// subscriberCount holds len(subscribers) once SubscriberCountSignal
+	// was called
+	subscriberCount atomic.Pointer[signal[int]]
+
+	// validator optionally rejects values before they are committed
+	validator func(T) error
+
+	// onRejected is called with values rejected by validator
+	onRejected func(T, error)
+
+	// interceptors transform or observe every write before it is committed
+	interceptors []func(old, new T) T
+
+	// callbackPool recycles subscriber snapshots between writes
+	callbackPool sync.Pool
+
 	// metrics for observability (lock-free counters)
 	reads  atomic.Int64
 	writes atomic.Int64
+
+	// changes counts committed writes, see version
+	changes atomic.Uint64
 }
 
 // New creates a new writable signal with the given initial value.
@@ -65,12 +209,117 @@ func New[T any](initial T) Signal[T] {
 //	    },
 //	})
 func NewWithOptions[T any](initial T, opts Options[T]) Signal[T] {
-	return &signal[T]{
-		value:       initial,
-		equal:       opts.Equal,
-		subscribers: make(map[uint64]func(T)),
-		onPanic:     opts.OnPanic,
+	return newSignal(initial, opts)
+}
+
+// FromOptions creates a writable signal holding opts.Initial, configured by
+// the rest of opts like NewWithOptions. Use it where signals are built from
+// configuration, e.g., injected Options structs.
+//
+// Example:
+//
+//	var retries = signals.Options[int]{Initial: 3, Equal: func(a, b int) bool { return a == b }}
+//	limit := signals.FromOptions(retries)
+//	fmt.Println(limit.Get()) // 3
+func FromOptions[T any](opts Options[T]) Signal[T] {
+	return NewWithOptions(opts.Initial, opts)
+}
+
+// MustNew is like NewWithOptions but panics if the options are invalid
+// (see Options.Check) or if Validate rejects the initial value.
+//
+// Use it to surface misconfiguration at construction, typically for
+// package-level signals and during development.
+//
+// Example:
+//
+//	var level = signals.MustNew(3, signals.Options[int]{
+//	    Validate: func(v int) error {
+//	        if v < 0 || v > 5 {
+//	            return errors.New("level out of range")
+//	        }
+//	        return nil
+//	    },
+//	})
+func MustNew[T any](initial T, opts Options[T]) Signal[T] {
+	if err := opts.Check(); err != nil {
+		panic(err)
+	}
+	if opts.Validate != nil {
+		if err := opts.Validate(initial); err != nil {
+			panic(fmt.Errorf("%w: initial value rejected by Validate: %w", ErrInvalidOptions, err))
+		}
+	}
+	return NewWithOptions(initial, opts)
+}
+
+// NewComparable creates a writable signal for a comparable type that uses ==
+// as its Equal function, so setting the current value again doesn't notify.
+//
+// This is the common case for ints, strings, bools, and other comparable types,
+// and matches Angular's default Object.is() equality for primitives.
+//
+// Example:
+//
+//	status := signals.NewComparable("idle")
+//	status.Set("idle")  // No notification - value unchanged
+//	status.Set("busy")  // Notifies subscribers
+func NewComparable[T comparable](initial T) Signal[T] {
+	return NewWithOptions(initial, Options[T]{
+		Equal: func(a, b T) bool { return a == b },
+	})
+}
+
+// NewRef creates a writable signal holding a pointer that uses pointer
+// identity as its Equal function, so setting the pointer it already holds
+// doesn't notify, while any other pointer does, even to an equal value.
+//
+// This matches Angular's Object.is() equality for objects. New never
+// dedups, so without it, re-setting the same pointer after mutating its
+// target in place notifies every subscriber again. Mutate a copy and set
+// the new pointer when subscribers must see the change.
+//
+// Example:
+//
+//	user := signals.NewRef(&User{Name: "Alice"})
+//	user.Set(user.Get())           // No notification - same pointer
+//	user.Set(&User{Name: "Alice"}) // Notifies subscribers
+func NewRef[T any](initial *T) Signal[*T] {
+	return NewWithOptions(initial, Options[*T]{
+		Equal: func(a, b *T) bool { return a == b },
+	})
+}
+
+// newSignal creates the concrete signal implementation.
+// Used internally by types that build on signal[T] directly.
+func newSignal[T any](initial T, opts Options[T]) *signal[T] {
+	s := &signal[T]{
+		lockFreeReads: opts.LockFreeReads,
+		subscribers:   make(map[uint64]func(T)),
+		reactions:     make(map[uint64]reaction),
+		watchers:      make(map[uint64]func()),
+		dependents:    make(map[uint64]DependentKind),
+		onPanic:       opts.OnPanic,
+		logger:        opts.Logger,
+		rePanicOn:     opts.RePanicOn,
+		validator:     opts.Validate,
+		onRejected:    opts.OnRejected,
+		interceptors:  slices.Clone(opts.Interceptors),
+		onTiming:      opts.OnTiming,
+		history:       newHistory(opts),
+		deliveries:    newDeliveries(opts),
+		slow:          newSlowWatch(opts),
+		freshness:     newFreshness(opts),
+		strong:        opts.StrongConsistency,
+
+		maxSubscribers: opts.MaxSubscribers,
+		onLimit:        opts.OnLimit,
+	}
+	if opts.Equal != nil {
+		s.equal.Store(&opts.Equal)
 	}
+	s.store(initial)
+	return s
 }
 
 // Get returns the current value of the signal.
@@ -78,44 +327,150 @@ func NewWithOptions[T any](initial T, opts Options[T]) Signal[T] {
 func (s *signal[T]) Get() T {
 	s.reads.Add(1) // Lock-free metric
 
+	if s.freshness != nil {
+		s.refreshIfStale()
+	}
+
+	if s.strong {
+		s.consistency.RLock() // Wait for a write's subscribers
+		defer s.consistency.RUnlock()
+	}
+
+	if s.lockFreeReads {
+		return *s.hot.Load()
+	}
+
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 	return s.value
 }
 
+// load returns the current value. Caller must hold mu (read or write).
+func (s *signal[T]) load() T {
+	if s.lockFreeReads {
+		return *s.hot.Load()
+	}
+	return s.value
+}
+
+// store replaces the current value. Caller must hold mu for writing,
+// except during construction.
+func (s *signal[T]) store(v T) {
+	if s.history != nil {
+		s.history.add(v)
+	}
+	if s.freshness != nil {
+		s.freshness.touch()
+	}
+	if s.lockFreeReads {
+		// Copy into a fresh cell; taking &v would make v escape on every path
+		p := new(T)
+		*p = v
+		s.hot.Store(p)
+		return
+	}
+	s.value = v
+}
+
 // Set replaces the signal's value with a new value.
 //
 // If a custom Equal function is provided, Set will check equality
 // and only notify subscribers if the value has changed.
 //
+// If a Validate function is provided and rejects the value, the signal
+// keeps its old value, subscribers are not notified, and OnRejected is called.
+//
 // All subscriber callbacks are executed with panic recovery.
 // One panicking subscriber does not affect others.
+//
+// Notifications are delivered one write at a time, in write order. A write
+// made while subscribers are being notified (e.g., by a subscriber writing
+// back to this signal) is queued and delivered once the current notification
+// completes, by the goroutine already delivering; such a Set returns without
+// waiting for it.
 func (s *signal[T]) Set(newValue T) {
+	_ = s.SetE(newValue)
+}
+
+// SetE is like Set but returns the validation error if the value is rejected.
+func (s *signal[T]) SetE(newValue T) error {
+	return s.set(newValue, nil, nil)
+}
+
+// SetSafe is like Set but returns the panics recovered from subscribers
+// during notification, as *PanicError values, instead of passing them to
+// OnPanic. All subscribers still run. Returns nil if none panicked.
+//
+// If Validate rejects the value, the returned slice holds only the
+// validation error (or ErrSignalClosed after Close).
+//
+// Panics are collected from every notification the call delivers, including
+// writes made by subscribers. If another goroutine is already delivering for
+// this signal, the notification is queued to it and its panics go to OnPanic.
+func (s *signal[T]) SetSafe(newValue T) []error {
+	var errs []error
+	if err := s.set(newValue, nil, &errs); err != nil {
+		return []error{err}
+	}
+	return errs
+}
+
+// SetWithMeta is like Set, also handing meta to SubscribeMeta subscribers
+// along with the value, e.g., to say where the change came from. Other
+// subscribers only see the value. Writes without meta deliver nil.
+func (s *signal[T]) SetWithMeta(value T, meta any) {
+	_ = s.set(value, meta, nil)
+}
+
+// set commits a write and notifies, passing meta to SubscribeMeta
+// subscribers. Recovered panics are appended to sink if it is non-nil,
+// otherwise reported to OnPanic.
+func (s *signal[T]) set(newValue T, meta any, sink *[]error) error {
+	if s.closed.Load() {
+		return ErrSignalClosed
+	}
+
+	// Interceptors need the old value, so the whole write runs under the lock,
+	// as does StrongConsistency delivery
+	if len(s.interceptors) > 0 || s.strong {
+		_, err := s.applyAndGet(func(T) (T, bool) { return newValue, true }, meta, sink)
+		return err
+	}
+	s.checkPure()
+
 	// Fast path: check equality without write lock
-	if s.equal != nil {
+	if equal := s.equalFunc(); equal != nil {
 		s.mu.RLock()
-		if s.equal(s.value, newValue) {
+		if equal(s.load(), newValue) {
 			s.mu.RUnlock()
-			return // Value hasn't changed, don't notify
+			return nil // Value hasn't changed, don't notify
 		}
 		s.mu.RUnlock()
 	}
 
+	if err := s.validate(newValue); err != nil {
+		s.reject(newValue, err)
+		return err
+	}
+
 	s.writes.Add(1) // Lock-free metric
 
 	// Update value and copy subscribers inside lock
 	s.mu.Lock()
-	s.value = newValue
-
-	// Copy subscribers to slice for safe iteration outside lock
-	callbacks := make([]func(T), 0, len(s.subscribers))
-	for _, fn := range s.subscribers {
-		callbacks = append(callbacks, fn)
+	if s.closed.Load() {
+		s.mu.Unlock()
+		return ErrSignalClosed
 	}
+	s.store(newValue)
+	s.changes.Add(1)
+	callbacks := s.snapshotSubscribers()
+	deliver := s.beginNotify(callbacks, newValue, meta)
+	reactions := s.snapshotReactions()
 	s.mu.Unlock()
 
-	// Notify subscribers outside lock (prevents deadlock)
-	s.notifySubscribers(callbacks, newValue)
+	// Notify outside lock (prevents deadlock)
+	s.notify(reactions, callbacks, deliver, newValue, meta, sink)
+	return nil
 }
 
 // Update transforms the signal's value using the provided function.
@@ -123,37 +478,342 @@ func (s *signal[T]) Set(newValue T) {
 // The transform function receives the current value and returns the new value.
 // The entire read-transform-write operation is atomic.
 //
+// The produced value is subject to Equal and Validate like Set.
+//
 // Example:
 //
 //	count.Update(func(v int) int { return v + 1 })
 func (s *signal[T]) Update(fn func(T) T) {
+	_ = s.apply(always(fn), nil)
+}
+
+// UpdateAndGet is like Update but returns the value held once the transform
+// is committed, read under the same lock as the transform. Concurrent
+// writers cannot interleave, so each caller sees exactly what it wrote.
+//
+// If the produced value is equal to the current one or rejected by Validate,
+// the unchanged current value is returned.
+func (s *signal[T]) UpdateAndGet(fn func(T) T) T {
+	current, _ := s.applyAndGet(always(fn), nil, nil)
+	return current
+}
+
+// CompareAndSwap sets the value to new and notifies subscribers only if the
+// current value equals old, reporting whether the swap happened. The compare
+// and the write are atomic under the write lock, enabling optimistic retry
+// loops without external locking.
+//
+// The comparison uses Options.Equal if set, otherwise ==. A signal of a
+// non-comparable type must have an Equal function; CompareAndSwap panics
+// without one. Values holding an interface of a non-comparable dynamic
+// type (such as a func in an any, or a struct with a slice in an any
+// field) never match. As with Set, subscribers are not notified if Equal
+// reports new equal to old, and the swap fails if Validate rejects new.
+func (s *signal[T]) CompareAndSwap(old, new T) bool {
+	matches := s.equalFunc()
+	if matches == nil {
+		if matches = comparableEqual[T](); matches == nil {
+			panic("signals: CompareAndSwap on a non-comparable type requires Options.Equal")
+		}
+	}
+
+	swapped := false
+	err := s.apply(func(cur T) (T, bool) {
+		swapped = matches(cur, old)
+		return new, swapped
+	}, nil)
+	return swapped && err == nil
+}
+
+// ReplaceIf sets the value to newValue and notifies subscribers only if
+// pred accepts the current value, reporting whether the swap happened. The
+// check and the write are atomic under the write lock: of concurrent calls
+// whose pred accepts one state, the first wins and the others see its result.
+//
+// Unlike Update, the new value is not computed from the current one, and
+// unlike CompareAndSwap, the current value needn't be known exactly. As with
+// Set, the swap fails if Validate rejects newValue.
+func (s *signal[T]) ReplaceIf(pred func(T) bool, newValue T) bool {
+	replaced := false
+	err := s.apply(func(cur T) (T, bool) {
+		replaced = pred(cur)
+		return newValue, replaced
+	}, nil)
+	return replaced && err == nil
+}
+
+// always adapts an unconditional transform for apply.
+func always[T any](fn func(T) T) func(T) (T, bool) {
+	return func(v T) (T, bool) { return fn(v), true }
+}
+
+// apply commits fn atomically and notifies subscribers outside the lock.
+// fn returns false to leave the value untouched.
+func (s *signal[T]) apply(fn func(T) (T, bool), sink *[]error) error {
+	_, err := s.applyAndGet(fn, nil, sink)
+	return err
+}
+
+// applyAndGet is apply, also returning the value held after the commit.
+// meta is passed to SubscribeMeta subscribers.
+func (s *signal[T]) applyAndGet(fn func(T) (T, bool), meta any, sink *[]error) (T, error) {
+	s.checkPure()
+	if s.strong {
+		return s.applyConsistent(fn, meta, sink)
+	}
+
+	newValue, w, err := s.commitUpdate(fn, meta)
+	if err != nil {
+		if !errors.Is(err, ErrSignalClosed) {
+			s.reject(newValue, err)
+		}
+		return w.current, err
+	}
+
+	// Notify outside lock
+	s.notify(w.reactions, w.callbacks, w.deliver, newValue, meta, sink)
+	return w.current, nil
+}
+
+// applyConsistent is applyAndGet for a StrongConsistency signal: subscribers
+// are notified before readers see the write, then reactions run.
+func (s *signal[T]) applyConsistent(fn func(T) (T, bool), meta any, sink *[]error) (T, error) {
+	if s.onTiming != nil {
+		defer reportTiming(s.onTiming, TimingNotify, time.Now())
+	}
+	newValue, w, err := s.commitAndDeliver(fn, meta, sink)
+	if err != nil {
+		if !errors.Is(err, ErrSignalClosed) {
+			s.reject(newValue, err)
+		}
+		return w.current, err
+	}
+	s.notifyReactions(w.reactions, sink)
+	return w.current, nil
+}
+
+// commitAndDeliver commits fn and delivers the write to subscribers while
+// holding readers off.
+func (s *signal[T]) commitAndDeliver(fn func(T) (T, bool), meta any, sink *[]error) (T, committedWrite[T], error) {
+	s.consistency.Lock()
+	defer s.consistency.Unlock()
+	newValue, w, err := s.commitUpdate(fn, meta)
+	if err == nil && w.deliver {
+		s.deliver(w.callbacks, newValue, meta, sink)
+	}
+	return newValue, w, err
+}
+
+// notify runs a committed write's reactions, then delivers it to callbacks
+// if deliver is set. The whole notification is timed for onTiming.
+func (s *signal[T]) notify(reactions []reaction, callbacks *[]func(T), deliver bool, value T, meta any, sink *[]error) {
+	if s.onTiming != nil {
+		defer reportTiming(s.onTiming, TimingNotify, time.Now())
+	}
+	s.notifyReactions(reactions, sink)
+	if deliver {
+		s.deliver(callbacks, value, meta, sink)
+	}
+}
+
+// committedWrite holds the outcome of a write and what it must notify.
+type committedWrite[T any] struct {
+	// current is the value held after the write (the old value if unchanged)
+	current T
+
+	// reactions are the computeds and effects to notify
+	reactions []reaction
+
+	// callbacks are the subscribers to notify (pooled, nil if none)
+	callbacks *[]func(T)
+
+	// deliver is false if the subscriber notification was queued
+	deliver bool
+
+	// version is the signal's version after the write, 0 if nothing was stored
+	version uint64
+}
+
+// commitUpdate runs the atomic read-transform-write under the write lock.
+// Returns the produced value and what to notify (empty if unchanged or fn
+// declined to write), or the validation error if the value was rejected
+// (ErrSignalClosed if closed). A queued notification carries meta.
+func (s *signal[T]) commitUpdate(fn func(T) (T, bool), meta any) (newValue T, w committedWrite[T], err error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
 	// Atomic read-transform-write
-	oldValue := s.value
-	newValue := fn(oldValue)
+	oldValue := s.load()
+	w.current = oldValue
+
+	if s.closed.Load() {
+		return newValue, w, ErrSignalClosed
+	}
+
+	newValue, write := fn(oldValue)
+	if !write {
+		return newValue, w, nil
+	}
+	newValue = s.intercept(oldValue, newValue)
 
 	// Check equality if custom function provided
-	if s.equal != nil && s.equal(oldValue, newValue) {
-		return
+	if equal := s.equalFunc(); equal != nil && equal(oldValue, newValue) {
+		return newValue, w, nil
+	}
+
+	if err := s.validate(newValue); err != nil {
+		return newValue, w, err
 	}
 
-	// Update value
-	s.value = newValue
+	s.writes.Add(1) // Lock-free metric
+	s.store(newValue)
+	w.version = s.changes.Add(1)
+	w.current = newValue
+	w.callbacks = s.snapshotSubscribers()
+	w.deliver = s.beginNotify(w.callbacks, newValue, meta)
+	w.reactions = s.snapshotReactions()
+	return newValue, w, nil
+}
 
-	// Copy subscribers before unlock
-	callbacks := make([]func(T), 0, len(s.subscribers))
-	for _, fn := range s.subscribers {
-		callbacks = append(callbacks, fn)
+// revert undoes a write committed by commitUpdate whose reactions have not
+// run, restoring prior unless the signal was written again since. A
+// notification still pending is dropped; one already being delivered is
+// followed by one of prior. Used to roll back a Transaction.
+func (s *signal[T]) revert(prior T, w committedWrite[T]) {
+	if w.version == 0 {
+		return // Nothing was stored
+	}
+	s.mu.Lock()
+	restored := s.changes.Load() == w.version
+	if restored {
+		s.store(prior)
+		s.changes.Add(1)
+	}
+	var callbacks *[]func(T)
+	deliver := false
+	if !w.deliver && w.callbacks != nil && !s.dropQueued(w.callbacks) && restored {
+		callbacks = s.snapshotSubscribers()
+		deliver = s.beginNotify(callbacks, prior, nil)
 	}
 	s.mu.Unlock()
 
-	// Notify outside lock
-	s.notifySubscribers(callbacks, newValue)
+	if w.deliver {
+		// The delivery was claimed and never started: hand the claim on
+		s.releaseCallbacks(w.callbacks)
+		if next, ok := s.nextQueued(0); ok {
+			s.deliver(next.callbacks, next.value, next.meta, nil)
+		}
+	}
+	if deliver {
+		s.deliver(callbacks, prior, nil, nil)
+	}
+}
 
-	// Re-acquire lock for defer
-	s.mu.Lock()
+// dropQueued removes the queued notification of callbacks, reporting
+// whether it was still queued. Caller must hold mu.
+func (s *signal[T]) dropQueued(callbacks *[]func(T)) bool {
+	for i, q := range s.queued {
+		if q.callbacks == callbacks {
+			s.queued = slices.Delete(s.queued, i, i+1)
+			s.releaseCallbacks(callbacks)
+			return true
+		}
+	}
+	return false
+}
+
+// intercept passes a write through the configured interceptors in order.
+// Caller must hold mu.
+func (s *signal[T]) intercept(oldValue, newValue T) T {
+	for _, fn := range s.interceptors {
+		newValue = fn(oldValue, newValue)
+	}
+	return newValue
+}
+
+// snapshotSubscribers copies subscribers to a pooled slice for safe iteration
+// outside lock, or returns nil if there are none. Release it with releaseCallbacks.
+// Caller must hold mu.
+func (s *signal[T]) snapshotSubscribers() *[]func(T) {
+	if len(s.subscribers) == 0 {
+		return nil
+	}
+
+	callbacks, ok := s.callbackPool.Get().(*[]func(T))
+	if !ok {
+		buf := make([]func(T), 0, len(s.subscribers))
+		callbacks = &buf
+	}
+	if len(s.priorities) > 0 {
+		for _, id := range s.orderedSubscribers() {
+			*callbacks = append(*callbacks, s.subscribers[id])
+		}
+		return callbacks
+	}
+	for _, fn := range s.subscribers {
+		*callbacks = append(*callbacks, fn)
+	}
+	return callbacks
+}
+
+// singleCallback returns a pooled snapshot holding only fn, to deliver to
+// one subscriber. Release it with releaseCallbacks.
+func (s *signal[T]) singleCallback(fn func(T)) *[]func(T) {
+	callbacks, ok := s.callbackPool.Get().(*[]func(T))
+	if !ok {
+		buf := make([]func(T), 0, 1)
+		callbacks = &buf
+	}
+	*callbacks = append(*callbacks, fn)
+	return callbacks
+}
+
+// orderedSubscribers returns the subscriber IDs by priority, highest first,
+// then in subscription order. Caller must hold mu.
+func (s *signal[T]) orderedSubscribers() []uint64 {
+	ids := slices.Collect(maps.Keys(s.subscribers))
+	slices.SortFunc(ids, func(a, b uint64) int {
+		if c := cmp.Compare(s.priorities[b], s.priorities[a]); c != 0 {
+			return c
+		}
+		return cmp.Compare(a, b)
+	})
+	return ids
+}
+
+// releaseCallbacks returns a snapshot to the pool once it has been delivered.
+func (s *signal[T]) releaseCallbacks(callbacks *[]func(T)) {
+	clear(*callbacks) // Don't keep unsubscribed callbacks alive
+	*callbacks = (*callbacks)[:0]
+	s.callbackPool.Put(callbacks)
+}
+
+// snapshotReactions copies computeds and effects for use outside lock.
+// Caller must hold mu.
+func (s *signal[T]) snapshotReactions() []reaction {
+	if len(s.reactions) == 0 {
+		return nil
+	}
+	reactions := make([]reaction, 0, len(s.reactions))
+	for _, r := range s.reactions {
+		reactions = append(reactions, r)
+	}
+	return reactions
+}
+
+// validate runs the optional Validate hook.
+func (s *signal[T]) validate(value T) error {
+	if s.validator == nil {
+		return nil
+	}
+	return s.validator(value)
+}
+
+// reject reports a write rejected by Validate to the optional OnRejected hook.
+func (s *signal[T]) reject(value T, err error) {
+	if s.onRejected != nil {
+		s.onRejected(value, err)
+	}
 }
 
 // Subscribe registers a callback to be notified when the signal's value changes.
@@ -173,45 +833,196 @@ func (s *signal[T]) Update(fn func(T) T) {
 //	    fmt.Println("Value:", v)
 //	})
 //	defer unsub()  // Cleanup (before context timeout)
+//
+// A subscription made during a notification (e.g., by another subscriber)
+// takes effect from the next write: fn is not called for the value being
+// delivered. After Close, nothing is registered: fn never runs and the
+// returned Unsubscribe is a no-op.
 func (s *signal[T]) Subscribe(ctx context.Context, fn func(T)) Unsubscribe {
+	return s.subscribe(ctx, fn, fn)
+}
+
+// subscribe registers fn as a subscriber, checking callback, the function
+// the caller subscribed, for duplicates.
+func (s *signal[T]) subscribe(ctx context.Context, fn func(T), callback any) Unsubscribe {
 	// Add subscriber with unique ID
 	s.mu.Lock()
+	id, err := s.addSubscriberLocked(fn)
+	s.mu.Unlock()
+	if err != nil {
+		s.rejectSubscriber(err)
+		return func() {}
+	}
+	s.publishSubscriberCount()
+	s.checkDuplicate(id, callback)
+
+	return trackLeak(s.watchSubscription(ctx, id), s.subscribed(id))
+}
+
+// addSubscriberLocked registers fn and returns its subscriber ID. It fails
+// with ErrSignalClosed after Close, or ErrSubscriberLimit (wrapped) at
+// MaxSubscribers. Caller must hold mu.
+func (s *signal[T]) addSubscriberLocked(fn func(T)) (uint64, error) {
+	if s.closed.Load() {
+		return 0, ErrSignalClosed
+	}
+	if s.maxSubscribers > 0 && len(s.subscribers) >= s.maxSubscribers {
+		return 0, fmt.Errorf("%w: %d subscribers", ErrSubscriberLimit, s.maxSubscribers)
+	}
 	id := s.nextID
 	s.nextID++
+	fn = watchSlow(s.slow, fn)
+	if s.deliveries != nil {
+		fn = s.deliveries.track(id, fn)
+	}
 	s.subscribers[id] = fn
+	return id, nil
+}
+
+// rejectSubscriber reports a subscription refused at the subscriber limit
+// to OnLimit, or logs it. Subscriptions to a closed signal are not reported.
+func (s *signal[T]) rejectSubscriber(err error) {
+	if !errors.Is(err, ErrSubscriberLimit) {
+		return
+	}
+	if s.onLimit != nil {
+		s.onLimit(err)
+		return
+	}
+	logError(s.logger, err)
+}
+
+// dropSubscriberLocked deletes subscriber id. Caller must hold mu.
+func (s *signal[T]) dropSubscriberLocked(id uint64) {
+	delete(s.subscribers, id)
+	delete(s.priorities, id)
+	delete(s.callbackIDs, id)
+	if s.deliveries != nil {
+		s.deliveries.remove(id)
+	}
+}
+
+// SubscribeWithPriority is like Subscribe, but fn runs before the
+// subscribers of lower priority. Subscribe has priority 0. Once a signal
+// has a prioritized subscriber, equal priorities run in subscription order
+// (plain subscribers otherwise run in no particular order).
+func (s *signal[T]) SubscribeWithPriority(ctx context.Context, priority int, fn func(T)) Unsubscribe {
+	s.mu.Lock()
+	id, err := s.addSubscriberLocked(fn)
+	if err != nil {
+		s.mu.Unlock()
+		s.rejectSubscriber(err)
+		return func() {}
+	}
+	if s.priorities == nil {
+		s.priorities = make(map[uint64]int)
+	}
+	s.priorities[id] = priority
 	s.mu.Unlock()
+	s.publishSubscriberCount()
+	s.checkDuplicate(id, fn)
 
-	// Channel to signal cleanup completion
-	done := make(chan struct{})
-
-	// Goroutine for context-based cleanup
-	go func() {
-		select {
-		case <-ctx.Done():
-			// Context canceled - auto cleanup
-			s.mu.Lock()
-			delete(s.subscribers, id)
-			s.mu.Unlock()
-			close(done)
-		case <-done:
-			// Manual unsubscribe happened
-		}
-	}()
+	return trackLeak(s.watchSubscription(ctx, id), s.subscribed(id))
+}
+
+// SubscribeMeta is like Subscribe, but fn also receives the metadata the
+// change was written with by SetWithMeta (nil for any other write).
+func (s *signal[T]) SubscribeMeta(ctx context.Context, fn func(T, any)) Unsubscribe {
+	return s.subscribe(ctx, func(v T) { fn(v, s.deliveryMeta) }, fn)
+}
+
+// watchSubscription ties subscriber id to ctx and returns its Unsubscribe.
+//
+// No goroutine is parked per subscription: contexts that can never be
+// canceled (SubscribeForever) need no watcher at all, and others use
+// context.AfterFunc, which only starts a goroutine once ctx is done.
+func (s *signal[T]) watchSubscription(ctx context.Context, id uint64) Unsubscribe {
+	if ctx.Done() == nil {
+		return func() { s.removeSubscriber(id) }
+	}
+
+	s.mu.Lock()
+	if s.closed.Load() {
+		// Closed since registration: nothing will ever be delivered
+		s.dropSubscriberLocked(id)
+		s.mu.Unlock()
+		return func() {}
+	}
+	// Context canceled - auto cleanup
+	stop := context.AfterFunc(ctx, func() { s.removeSubscriber(id) })
+	s.watchers[id] = func() { stop() }
+	s.mu.Unlock()
 
 	// Return manual unsubscribe function
 	return func() {
-		s.mu.Lock()
-		delete(s.subscribers, id)
+		s.removeSubscriber(id)
+		stop()
+	}
+}
+
+// subscribed returns a func reporting whether subscriber id is still
+// registered, for trackLeak.
+func (s *signal[T]) subscribed(id uint64) func() bool {
+	return func() bool {
+		s.mu.RLock()
+		defer s.mu.RUnlock()
+		_, ok := s.subscribers[id]
+		return ok
+	}
+}
+
+// removeSubscriber deletes subscriber id and its watcher.
+func (s *signal[T]) removeSubscriber(id uint64) {
+	s.mu.Lock()
+	s.dropSubscriberLocked(id)
+	delete(s.watchers, id)
+	s.mu.Unlock()
+	s.publishSubscriberCount()
+}
+
+// Close removes every subscriber, computed, and effect registered on the
+// signal and stops watching their contexts. Notifications that are
+// queued but not yet delivered are dropped.
+//
+// After Close the signal keeps its last value for Get, but writes are
+// no-ops: Set and Update do nothing and SetE returns ErrSignalClosed.
+// Later subscriptions (and computeds or effects depending on the signal)
+// register nothing and get a no-op Unsubscribe. Close is idempotent.
+//
+// Use Close when tearing down a subsystem, instead of relying on every
+// holder to call its Unsubscribe.
+func (s *signal[T]) Close() {
+	s.mu.Lock()
+	if s.closed.Load() {
 		s.mu.Unlock()
+		return
+	}
+	s.closed.Store(true)
 
-		// Signal goroutine to stop
-		select {
-		case <-done:
-			// Already closed by context
-		default:
-			close(done)
-		}
+	watchers := s.watchers
+	s.subscribers = make(map[uint64]func(T))
+	s.priorities = nil
+	s.callbackIDs = nil
+	if s.deliveries != nil {
+		s.deliveries.reset()
+	}
+	s.reactions = make(map[uint64]reaction)
+	s.dependents = make(map[uint64]DependentKind)
+	s.watchers = make(map[uint64]func())
+	s.queued = nil
+	s.mu.Unlock()
+
+	for _, stop := range watchers {
+		stop()
 	}
+	s.publishSubscriberCount()
+}
+
+// CloseWith delivers a final value to subscribers, then closes the signal.
+// Writes racing with CloseWith may land before it is closed.
+func (s *signal[T]) CloseWith(final T) {
+	s.Set(final)
+	s.Close()
 }
 
 // SubscribeForever registers a callback that will never be automatically canceled.
@@ -229,28 +1040,314 @@ func (s *signal[T]) SubscribeForever(fn func(T)) Unsubscribe {
 	return s.Subscribe(context.Background(), fn)
 }
 
+// Observe calls fn with the current value, then on every change.
+// The current value is delivered like a change to fn alone: it is queued
+// behind any delivery in progress, with panic recovery, so fn never runs
+// concurrently with itself and sees values in commit order. Called from a
+// subscriber of this signal, fn gets the current value once that delivery
+// is done. After Close (or at MaxSubscribers), fn only gets the current value.
+func (s *signal[T]) Observe(fn func(T)) Unsubscribe {
+	s.reads.Add(1)
+	s.mu.Lock()
+	id, err := s.addSubscriberLocked(fn)
+	current := s.load()
+	callbacks := s.singleCallback(fn)
+	deliver := s.beginNotify(callbacks, current, nil)
+	s.mu.Unlock()
+
+	unsubscribe := func() {}
+	if err == nil {
+		s.publishSubscriberCount()
+		s.checkDuplicate(id, fn)
+		unsubscribe = trackLeak(s.watchSubscription(context.Background(), id), s.subscribed(id))
+	} else {
+		s.rejectSubscriber(err)
+	}
+	if deliver {
+		s.deliver(callbacks, current, nil, nil)
+	}
+	return unsubscribe
+}
+
+// version returns the number of committed writes. It is advanced before
+// computeds and effects are notified of the write.
+func (s *signal[T]) version() uint64 {
+	return s.changes.Load()
+}
+
 // AsReadonly returns a read-only view of this signal.
 // Use for encapsulation - keep Signal private, expose ReadonlySignal.
 func (s *signal[T]) AsReadonly() ReadonlySignal[T] {
-	return &readonlySignal[T]{source: s}
+	return newReadonly[T](s)
+}
+
+// Dependents reports how many computeds and effects depend on this signal.
+func (s *signal[T]) Dependents() Dependents {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return countDependents(s.dependents)
+}
+
+// SubscriberCountSignal returns a signal holding the number of subscribers.
+// It is created on first use; until then, subscribing pays nothing for it.
+func (s *signal[T]) SubscriberCountSignal() ReadonlySignal[int] {
+	if counter := s.subscriberCount.Load(); counter != nil {
+		return counter.AsReadonly()
+	}
+	counter := newSignal(0, Options[int]{Equal: func(a, b int) bool { return a == b }})
+	if !s.subscriberCount.CompareAndSwap(nil, counter) {
+		return s.subscriberCount.Load().AsReadonly()
+	}
+	s.publishSubscriberCount()
+	return counter.AsReadonly()
+}
+
+// publishSubscriberCount updates the SubscriberCountSignal, if any, after
+// subscribers changed. Caller must not hold mu. Reading the count under the
+// counter's write lock keeps concurrent updates from landing out of order.
+func (s *signal[T]) publishSubscriberCount() {
+	counter := s.subscriberCount.Load()
+	if counter == nil {
+		return
+	}
+	counter.Update(func(int) int {
+		s.mu.RLock()
+		defer s.mu.RUnlock()
+		return len(s.subscribers)
+	})
+}
+
+// subscribeDependent registers a computed or effect as a dependent.
+// Dependents never auto-cancel, so no context goroutine is needed.
+func (s *signal[T]) subscribeDependent(kind DependentKind, r reaction) Unsubscribe {
+	s.mu.Lock()
+	if s.closed.Load() {
+		s.mu.Unlock()
+		return func() {} // Never changes again
+	}
+	id := s.nextID
+	s.nextID++
+	s.reactions[id] = r
+	s.dependents[id] = kind
+	s.mu.Unlock()
+
+	return func() {
+		s.mu.Lock()
+		delete(s.reactions, id)
+		delete(s.dependents, id)
+		s.mu.Unlock()
+	}
+}
+
+// equalFunc returns the current Equal, or nil if there is none.
+func (s *signal[T]) equalFunc() EqualFunc[T] {
+	if equal := s.equal.Load(); equal != nil {
+		return *equal
+	}
+	return nil
+}
+
+// SetEqual replaces the signal's Equal; nil restores the default of always
+// notifying. It is safe to call concurrently with writes: the swap happens
+// under the write lock, so a write in progress finishes with the old Equal
+// and only later writes use fn. Subscribers are kept.
+func (s *signal[T]) SetEqual(fn EqualFunc[T]) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if fn == nil {
+		s.equal.Store(nil)
+		return
+	}
+	s.equal.Store(&fn)
+}
+
+// Fork returns a new independent signal with the current value and the same options.
+func (s *signal[T]) Fork() Signal[T] {
+	return newSignal(s.Get(), s.options())
+}
+
+// options reconstructs the Options this signal was created with.
+func (s *signal[T]) options() Options[T] {
+	opts := Options[T]{
+		Equal:             s.equalFunc(),
+		OnPanic:           s.onPanic,
+		Logger:            s.logger,
+		RePanicOn:         s.rePanicOn,
+		Validate:          s.validator,
+		OnRejected:        s.onRejected,
+		Interceptors:      s.interceptors,
+		LockFreeReads:     s.lockFreeReads,
+		StrongConsistency: s.strong,
+		OnTiming:          s.onTiming,
+		RecordHistory:     s.history != nil,
+		HistoryLimit:      s.historyLimit(),
+
+		TrackDeliveries: s.deliveries != nil,
+		MaxSubscribers:  s.maxSubscribers,
+		OnLimit:         s.onLimit,
+
+		SlowSubscriberThreshold: s.slowThreshold(),
+		OnSlow:                  s.onSlow(),
+	}
+	if s.freshness != nil {
+		opts.MaxAge, opts.Refresh = s.freshness.maxAge, s.freshness.refresh
+		opts.Clock = s.freshness.clock
+	}
+	return opts
+}
+
+// historyLimit returns the history's limit, 0 if recording is off.
+func (s *signal[T]) historyLimit() int {
+	if s.history == nil {
+		return 0
+	}
+	return s.history.limit
+}
+
+// slowThreshold returns Options.SlowSubscriberThreshold, 0 if timing is off.
+func (s *signal[T]) slowThreshold() time.Duration {
+	if s.slow == nil {
+		return 0
+	}
+	return s.slow.threshold
+}
+
+// onSlow returns Options.OnSlow, nil if timing is off.
+func (s *signal[T]) onSlow() func(SlowSubscriber) {
+	if s.slow == nil {
+		return nil
+	}
+	return s.slow.onSlow
+}
+
+// beginNotify claims delivery of a notification, or queues it behind the
+// delivery in progress. Returns true if the caller must call deliver.
+// Caller must hold mu.
+//
+// Queuing keeps delivery ordered and makes writes from inside a subscriber
+// (directly or through other signals) iterative instead of recursive.
+func (s *signal[T]) beginNotify(callbacks *[]func(T), value T, meta any) bool {
+	if callbacks == nil {
+		return false
+	}
+	if s.notifying {
+		s.queued = append(s.queued, queuedNotification[T]{callbacks: callbacks, value: value, meta: meta})
+		return false
+	}
+	s.notifying = true
+	return true
+}
+
+// deliver notifies subscribers, then drains notifications queued meanwhile.
+// Must only be called after beginNotify returned true.
+func (s *signal[T]) deliver(callbacks *[]func(T), value T, meta any, sink *[]error) {
+	if s.rePanicOn != nil {
+		defer s.abortDelivery()
+	}
+	for followUps := 0; ; followUps++ {
+		s.deliveryMeta = meta
+		s.notifySubscribers(*callbacks, value, sink)
+		s.deliveryMeta = nil
+		s.releaseCallbacks(callbacks)
+
+		next, ok := s.nextQueued(followUps)
+		if !ok {
+			return
+		}
+		callbacks, value, meta = next.callbacks, next.value, next.meta
+	}
+}
+
+// abortDelivery ends the delivery in progress when a panic raised again
+// by RePanicOn escapes deliver, dropping queued notifications, so later
+// writes still notify. Must be called via defer.
+func (s *signal[T]) abortDelivery() {
+	if r := recover(); r != nil {
+		s.mu.Lock()
+		s.queued = nil
+		s.deliveryMeta = nil
+		s.notifying = false
+		s.mu.Unlock()
+		panic(r)
+	}
+}
+
+// nextQueued pops the next queued notification, ending delivery if there
+// is none. Past maxNotifyFollowUps the queue is dropped and ErrNotifyLoop reported.
+func (s *signal[T]) nextQueued(followUps int) (queuedNotification[T], bool) {
+	s.mu.Lock()
+	if len(s.queued) > 0 && followUps < maxNotifyFollowUps {
+		next := s.queued[0]
+		s.queued[0] = queuedNotification[T]{} // Release references
+		s.queued = s.queued[1:]
+		s.mu.Unlock()
+		return next, true
+	}
+
+	looping := len(s.queued) > 0
+	s.queued = nil
+	s.notifying = false
+	s.mu.Unlock()
+
+	if looping {
+		s.reportError(ErrNotifyLoop)
+	}
+	return queuedNotification[T]{}, false
+}
+
+// reportError reports an internal error to OnPanic, or logs it.
+func (s *signal[T]) reportError(err error) {
+	if s.onPanic != nil {
+		s.onPanic(err, debug.Stack())
+	} else {
+		logError(s.logger, err)
+	}
 }
 
 // notifySubscribers calls all subscriber callbacks with panic recovery.
 // One panicking subscriber does not affect others.
-func (s *signal[T]) notifySubscribers(callbacks []func(T), value T) {
+func (s *signal[T]) notifySubscribers(callbacks []func(T), value T, sink *[]error) {
 	for _, fn := range callbacks {
 		func() {
-			defer func() {
-				if r := recover(); r != nil {
-					if s.onPanic != nil {
-						s.onPanic(r, debug.Stack())
-					} else {
-						// Default: log and continue
-						log.Printf("signals: panic in subscriber: %v\n%s", r, debug.Stack())
-					}
-				}
-			}()
+			defer s.recoverSubscriber(sink)
 			fn(value)
 		}()
 	}
 }
+
+// notifyReactions propagates a change to computeds and effects (see wave.go),
+// with panic recovery.
+//
+// Unlike subscribers they run immediately, even when nested in another
+// notification: an effect writing to its own dependency must observe the
+// re-entry to report it instead of looping.
+func (s *signal[T]) notifyReactions(reactions []reaction, sink *[]error) {
+	if len(reactions) == 0 {
+		return
+	}
+	propagate(reactions, s.handlePanic, sink)
+}
+
+// recoverSubscriber recovers a panicking callback, collecting it into sink
+// if non-nil, and raises it again if rePanicOn says so. Must be called via defer.
+func (s *signal[T]) recoverSubscriber(sink *[]error) {
+	if r := recover(); r != nil {
+		s.handlePanic(r, sink)
+		if s.rePanicOn != nil && s.rePanicOn(r) {
+			panic(r)
+		}
+	}
+}
+
+// handlePanic collects a recovered callback panic into sink if non-nil,
+// or reports it.
+func (s *signal[T]) handlePanic(r any, sink *[]error) {
+	if sink != nil {
+		*sink = append(*sink, &PanicError{Value: r, Stack: debug.Stack()})
+	} else if s.onPanic != nil {
+		s.onPanic(r, debug.Stack())
+	} else {
+		// Default: log and continue
+		logPanic(s.logger, "subscriber", r, debug.Stack())
+	}
+}
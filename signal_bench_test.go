@@ -2,6 +2,7 @@ package signals
 
 import (
 	"context"
+	"runtime"
 	"testing"
 )
 
@@ -15,9 +16,42 @@ func BenchmarkSignal_Get(b *testing.B) {
 	}
 }
 
-// BenchmarkSignal_Set measures write performance (no subscribers)
+// BenchmarkSignal_GetParallelWithWriter measures read throughput under a concurrent writer
+func BenchmarkSignal_GetParallelWithWriter(b *testing.B) {
+	b.Run("RWMutex", func(b *testing.B) {
+		benchmarkGetParallelWithWriter(b, New(0))
+	})
+	b.Run("LockFreeReads", func(b *testing.B) {
+		benchmarkGetParallelWithWriter(b, NewWithOptions(0, Options[int]{LockFreeReads: true}))
+	})
+}
+
+func benchmarkGetParallelWithWriter(b *testing.B, sig Signal[int]) {
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		for i := 0; ; i++ {
+			select {
+			case <-stop:
+				return
+			default:
+				sig.Set(i)
+			}
+		}
+	}()
+
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			_ = sig.Get()
+		}
+	})
+}
+
+// BenchmarkSignal_Set measures write performance (no subscribers, no allocations)
 func BenchmarkSignal_Set(b *testing.B) {
 	sig := New(0)
+	b.ReportAllocs()
 
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
@@ -43,6 +77,20 @@ func BenchmarkSignal_SetWithSubscribers(b *testing.B) {
 	}
 }
 
+// BenchmarkSignal_SetWithManySubscribers measures pooled snapshots with 100 subscribers
+func BenchmarkSignal_SetWithManySubscribers(b *testing.B) {
+	sig := New(0)
+	for i := 0; i < 100; i++ {
+		sig.SubscribeForever(func(v int) { _ = v })
+	}
+	b.ReportAllocs()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		sig.Set(i)
+	}
+}
+
 // BenchmarkSignal_Update measures Update performance
 func BenchmarkSignal_Update(b *testing.B) {
 	sig := New(0)
@@ -65,6 +113,27 @@ func BenchmarkSignal_Subscribe(b *testing.B) {
 	}
 }
 
+// BenchmarkSignal_SubscribeForever10k measures memory and goroutines for 10k subscriptions
+func BenchmarkSignal_SubscribeForever10k(b *testing.B) {
+	const n = 10_000
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		sig := New(0)
+		before := runtime.NumGoroutine()
+
+		unsubs := make([]Unsubscribe, n)
+		for j := range unsubs {
+			unsubs[j] = sig.SubscribeForever(func(v int) {})
+		}
+		b.ReportMetric(float64(runtime.NumGoroutine()-before), "goroutines")
+
+		for _, unsub := range unsubs {
+			unsub()
+		}
+	}
+}
+
 // BenchmarkSignal_Unsubscribe measures unsubscription performance
 func BenchmarkSignal_Unsubscribe(b *testing.B) {
 	sig := New(0)
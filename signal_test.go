@@ -2,6 +2,13 @@ package signals
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"runtime"
+	"slices"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -488,3 +495,1463 @@ func TestSignal_ConcurrentSubscribe(t *testing.T) {
 		t.Errorf("After concurrent subscribe/unsubscribe, %d subscribers remain, want 0", count)
 	}
 }
+
+// TestSignal_Fork verifies a fork is independent and carries over options
+func TestSignal_Fork(t *testing.T) {
+	sig := NewWithOptions(1, Options[int]{
+		Equal: func(a, b int) bool { return a == b },
+	})
+
+	var origCalls, forkCalls int32
+	unsubOrig := sig.SubscribeForever(func(v int) {
+		atomic.AddInt32(&origCalls, 1)
+	})
+	defer unsubOrig()
+
+	fork := sig.Fork()
+	if got := fork.Get(); got != 1 {
+		t.Fatalf("fork.Get() = %d, want 1", got)
+	}
+
+	unsubFork := fork.SubscribeForever(func(v int) {
+		atomic.AddInt32(&forkCalls, 1)
+	})
+	defer unsubFork()
+
+	fork.Set(2)
+	sig.Set(3)
+
+	if got := sig.Get(); got != 3 {
+		t.Errorf("sig.Get() = %d, want 3", got)
+	}
+	if got := fork.Get(); got != 2 {
+		t.Errorf("fork.Get() = %d, want 2", got)
+	}
+	if got := atomic.LoadInt32(&origCalls); got != 1 {
+		t.Errorf("original notified %d times, want 1", got)
+	}
+	if got := atomic.LoadInt32(&forkCalls); got != 1 {
+		t.Errorf("fork notified %d times, want 1", got)
+	}
+
+	// Equal was carried over: no-op Set on the fork must not notify
+	fork.Set(2)
+	if got := atomic.LoadInt32(&forkCalls); got != 1 {
+		t.Errorf("fork notified %d times after no-op Set, want 1", got)
+	}
+}
+
+// TestSignal_Validate verifies invalid writes are rejected without notification
+func TestSignal_Validate(t *testing.T) {
+	errNegative := errors.New("must be non-negative")
+	var rejected []int
+
+	sig := NewWithOptions(10, Options[int]{
+		Validate: func(v int) error {
+			if v < 0 {
+				return errNegative
+			}
+			return nil
+		},
+		OnRejected: func(v int, err error) {
+			rejected = append(rejected, v)
+		},
+	})
+
+	var calls int32
+	unsub := sig.SubscribeForever(func(v int) {
+		atomic.AddInt32(&calls, 1)
+	})
+	defer unsub()
+
+	sig.Set(-1)
+	if got := sig.Get(); got != 10 {
+		t.Errorf("After invalid Set, Get() = %d, want 10", got)
+	}
+
+	if err := sig.SetE(-2); !errors.Is(err, errNegative) {
+		t.Errorf("SetE(-2) error = %v, want %v", err, errNegative)
+	}
+
+	// Update validates the produced value
+	sig.Update(func(v int) int { return v - 100 })
+	if got := sig.Get(); got != 10 {
+		t.Errorf("After invalid Update, Get() = %d, want 10", got)
+	}
+
+	if got := atomic.LoadInt32(&calls); got != 0 {
+		t.Errorf("Subscribers notified %d times for rejected writes, want 0", got)
+	}
+	if len(rejected) != 3 || rejected[0] != -1 || rejected[1] != -2 || rejected[2] != -90 {
+		t.Errorf("OnRejected values = %v, want [-1 -2 -90]", rejected)
+	}
+
+	// Valid writes still go through
+	if err := sig.SetE(5); err != nil {
+		t.Errorf("SetE(5) error = %v, want nil", err)
+	}
+	sig.Update(func(v int) int { return v + 1 })
+	if got := sig.Get(); got != 6 {
+		t.Errorf("After valid writes, Get() = %d, want 6", got)
+	}
+	if got := atomic.LoadInt32(&calls); got != 2 {
+		t.Errorf("Subscribers notified %d times for valid writes, want 2", got)
+	}
+}
+
+// TestSignal_NewRef verifies re-setting the same pointer doesn't notify, while a different pointer does
+func TestSignal_NewRef(t *testing.T) {
+	type user struct{ name string }
+	alice := &user{name: "alice"}
+	sig := NewRef(alice)
+
+	var calls int
+	sig.SubscribeForever(func(*user) { calls++ })
+
+	sig.Set(alice)
+	if calls != 0 {
+		t.Errorf("After Set(same pointer), called = %d, want 0", calls)
+	}
+
+	sig.Set(&user{name: "alice"}) // Equal value, different pointer
+	sig.Set(nil)
+	sig.Set(nil)
+	if calls != 2 {
+		t.Errorf("After two new pointers, called = %d, want 2", calls)
+	}
+}
+
+// TestSignal_NewComparable verifies setting the same value doesn't notify
+func TestSignal_NewComparable(t *testing.T) {
+	sig := NewComparable("idle")
+
+	var calls int32
+	unsub := sig.SubscribeForever(func(v string) {
+		atomic.AddInt32(&calls, 1)
+	})
+	defer unsub()
+
+	sig.Set("idle")
+	if got := atomic.LoadInt32(&calls); got != 0 {
+		t.Errorf("After Set(same), called = %d, want 0", got)
+	}
+
+	sig.Set("busy")
+	sig.Set("busy")
+	sig.Update(func(v string) string { return v })
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Errorf("After one real change, called = %d, want 1", got)
+	}
+	if got := sig.Get(); got != "busy" {
+		t.Errorf("Get() = %q, want %q", got, "busy")
+	}
+}
+
+// TestSignal_Interceptors verifies interceptors transform stored and notified values
+func TestSignal_Interceptors(t *testing.T) {
+	var audit []string
+	sig := NewWithOptions(50, Options[int]{
+		Interceptors: []func(old, new int) int{
+			func(_, v int) int { return min(max(v, 0), 100) },
+			func(old, v int) int {
+				audit = append(audit, fmt.Sprintf("%d->%d", old, v))
+				return v
+			},
+		},
+	})
+
+	var notified []int
+	unsub := sig.SubscribeForever(func(v int) {
+		notified = append(notified, v)
+	})
+	defer unsub()
+
+	sig.Set(150)
+	if got := sig.Get(); got != 100 {
+		t.Errorf("After Set(150), Get() = %d, want 100", got)
+	}
+
+	sig.Update(func(v int) int { return v - 500 })
+	if got := sig.Get(); got != 0 {
+		t.Errorf("After Update(-500), Get() = %d, want 0", got)
+	}
+
+	sig.Set(42)
+
+	wantNotified := []int{100, 0, 42}
+	if fmt.Sprint(notified) != fmt.Sprint(wantNotified) {
+		t.Errorf("notified = %v, want %v", notified, wantNotified)
+	}
+	wantAudit := []string{"50->100", "100->0", "0->42"}
+	if fmt.Sprint(audit) != fmt.Sprint(wantAudit) {
+		t.Errorf("audit = %v, want %v", audit, wantAudit)
+	}
+}
+
+// TestSignal_InterceptorsWithEqual verifies equality is checked on the intercepted value
+func TestSignal_InterceptorsWithEqual(t *testing.T) {
+	sig := NewWithOptions(100, Options[int]{
+		Equal:        func(a, b int) bool { return a == b },
+		Interceptors: []func(old, new int) int{func(_, v int) int { return min(v, 100) }},
+	})
+
+	var calls int32
+	unsub := sig.SubscribeForever(func(int) { atomic.AddInt32(&calls, 1) })
+	defer unsub()
+
+	sig.Set(200) // Clamped to 100, equal to current value
+
+	if got := atomic.LoadInt32(&calls); got != 0 {
+		t.Errorf("called = %d, want 0 for write clamped to current value", got)
+	}
+}
+
+// TestSignal_WriteBackFromSubscriber verifies a subscriber writing to its own
+// signal gets ordered, non-recursive delivery
+func TestSignal_WriteBackFromSubscriber(t *testing.T) {
+	sig := New(0)
+
+	var seen []int
+	depth, maxDepth := 0, 0
+	unsub := sig.SubscribeForever(func(v int) {
+		depth++
+		maxDepth = max(maxDepth, depth)
+		seen = append(seen, v)
+		if v < 5 {
+			sig.Set(v + 1)
+		}
+		depth--
+	})
+	defer unsub()
+
+	sig.Set(1)
+
+	if want := []int{1, 2, 3, 4, 5}; !slices.Equal(seen, want) {
+		t.Errorf("delivered %v, want %v", seen, want)
+	}
+	if maxDepth != 1 {
+		t.Errorf("subscriber nesting depth = %d, want 1", maxDepth)
+	}
+	if got := sig.Get(); got != 5 {
+		t.Errorf("Get() = %d, want 5", got)
+	}
+}
+
+// TestSignal_WriteBackOrderAcrossSubscribers verifies every subscriber sees
+// each value before any subscriber sees the next one
+func TestSignal_WriteBackOrderAcrossSubscribers(t *testing.T) {
+	sig := New(0)
+
+	var log []string
+	unsubA := sig.SubscribeForever(func(v int) {
+		log = append(log, fmt.Sprintf("a%d", v))
+		if v == 1 {
+			sig.Set(2)
+		}
+	})
+	defer unsubA()
+	unsubB := sig.SubscribeForever(func(v int) {
+		log = append(log, fmt.Sprintf("b%d", v))
+	})
+	defer unsubB()
+
+	sig.Set(1)
+
+	// Map iteration order is random, so check per-value grouping
+	if len(log) != 4 {
+		t.Fatalf("delivered %v, want 4 notifications", log)
+	}
+	for i, entry := range log {
+		want := "1"
+		if i >= 2 {
+			want = "2"
+		}
+		if entry[1:] != want {
+			t.Errorf("delivery order %v: value 2 interleaved with value 1", log)
+			break
+		}
+	}
+}
+
+// TestSignal_WriteBackLoopBounded verifies an endless write-back loop is cut
+// off and reported instead of overflowing the stack
+func TestSignal_WriteBackLoopBounded(t *testing.T) {
+	var reported int32
+	sig := NewWithOptions(0, Options[int]{
+		OnPanic: func(err any, _ []byte) {
+			if e, ok := err.(error); ok && errors.Is(e, ErrNotifyLoop) {
+				atomic.AddInt32(&reported, 1)
+			}
+		},
+	})
+
+	var calls int
+	unsub := sig.SubscribeForever(func(v int) {
+		calls++
+		sig.Set(v + 1)
+	})
+	defer unsub()
+
+	sig.Set(1)
+
+	if calls != maxNotifyFollowUps+1 {
+		t.Errorf("subscriber called %d times, want %d", calls, maxNotifyFollowUps+1)
+	}
+	if got := atomic.LoadInt32(&reported); got != 1 {
+		t.Errorf("ErrNotifyLoop reported %d times, want 1", got)
+	}
+
+	// Delivery state is reset: a later write notifies again
+	unsub()
+	var after int32
+	defer sig.SubscribeForever(func(int) { atomic.AddInt32(&after, 1) })()
+	sig.Set(-1)
+	if got := atomic.LoadInt32(&after); got != 1 {
+		t.Errorf("subscriber after loop called %d times, want 1", got)
+	}
+}
+
+// TestSignal_SetSafe verifies SetSafe returns subscriber panics and still runs every subscriber
+func TestSignal_SetSafe(t *testing.T) {
+	var handled int32
+	sig := NewWithOptions(0, Options[int]{
+		OnPanic: func(any, []byte) { atomic.AddInt32(&handled, 1) },
+	})
+
+	boom := errors.New("boom")
+	defer sig.SubscribeForever(func(int) { panic(boom) })()
+
+	var got int32
+	defer sig.SubscribeForever(func(v int) { atomic.StoreInt32(&got, int32(v)) })()
+
+	errs := sig.(SafeSetter[int]).SetSafe(7)
+
+	if len(errs) != 1 {
+		t.Fatalf("SetSafe returned %d errors, want 1: %v", len(errs), errs)
+	}
+	var pe *PanicError
+	if !errors.As(errs[0], &pe) || len(pe.Stack) == 0 {
+		t.Errorf("error = %#v, want *PanicError with stack", errs[0])
+	}
+	if !errors.Is(errs[0], boom) {
+		t.Errorf("errors.Is(%v, boom) = false, want true", errs[0])
+	}
+	if v := atomic.LoadInt32(&got); v != 7 {
+		t.Errorf("normal subscriber saw %d, want 7", v)
+	}
+	if n := atomic.LoadInt32(&handled); n != 0 {
+		t.Errorf("OnPanic called %d times, want 0", n)
+	}
+
+	// Set keeps reporting to OnPanic
+	sig.Set(8)
+	if n := atomic.LoadInt32(&handled); n != 1 {
+		t.Errorf("OnPanic called %d times after Set, want 1", n)
+	}
+}
+
+// TestSignal_SetSafeNoPanics verifies SetSafe returns nil when subscribers succeed
+func TestSignal_SetSafeNoPanics(t *testing.T) {
+	sig := NewWithOptions(0, Options[int]{
+		Validate: func(v int) error {
+			if v < 0 {
+				return errors.New("negative")
+			}
+			return nil
+		},
+	})
+	defer sig.SubscribeForever(func(int) {})()
+
+	safe := sig.(SafeSetter[int])
+	if errs := safe.SetSafe(1); errs != nil {
+		t.Errorf("SetSafe(1) = %v, want nil", errs)
+	}
+	if errs := safe.SetSafe(-1); len(errs) != 1 || errs[0].Error() != "negative" {
+		t.Errorf("SetSafe(-1) = %v, want [negative]", errs)
+	}
+}
+
+// TestSignal_Close verifies Close removes subscribers, stops watcher goroutines, and disables writes
+func TestSignal_Close(t *testing.T) {
+	sig := New(0)
+	before := runtime.NumGoroutine()
+
+	var calls int32
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	for i := 0; i < 50; i++ {
+		sig.Subscribe(ctx, func(int) { atomic.AddInt32(&calls, 1) })
+	}
+	sig.SubscribeForever(func(int) { atomic.AddInt32(&calls, 1) })
+
+	var effectRuns int32
+	Effect(func() {
+		sig.Get()
+		atomic.AddInt32(&effectRuns, 1)
+	}, sig.AsReadonly())
+
+	sig.Close()
+
+	deadline := time.Now().Add(2 * time.Second)
+	for runtime.NumGoroutine() > before {
+		if time.Now().After(deadline) {
+			t.Fatalf("goroutines = %d after Close, want <= %d", runtime.NumGoroutine(), before)
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	sig.Set(1)
+	sig.Update(func(v int) int { return v + 1 })
+	if err := sig.SetE(2); !errors.Is(err, ErrSignalClosed) {
+		t.Errorf("SetE after Close = %v, want ErrSignalClosed", err)
+	}
+	if got := atomic.LoadInt32(&calls); got != 0 {
+		t.Errorf("subscribers called %d times after Close, want 0", got)
+	}
+	if got := atomic.LoadInt32(&effectRuns); got != 1 {
+		t.Errorf("effect ran %d times, want 1 (initial only)", got)
+	}
+	if got := sig.Get(); got != 0 {
+		t.Errorf("Get() after Close = %d, want 0", got)
+	}
+	if d := sig.Dependents(); d.Total() != 0 {
+		t.Errorf("Dependents() after Close = %+v, want none", d)
+	}
+
+	sig.Close() // Idempotent
+}
+
+// TestSignal_CloseWith verifies the final value is delivered before closing
+func TestSignal_CloseWith(t *testing.T) {
+	sig := New("running")
+
+	var got []string
+	sig.SubscribeForever(func(v string) { got = append(got, v) })
+
+	sig.CloseWith("stopped")
+	sig.Set("ignored")
+
+	if !slices.Equal(got, []string{"stopped"}) {
+		t.Errorf("delivered %v, want [stopped]", got)
+	}
+	if v := sig.Get(); v != "stopped" {
+		t.Errorf("Get() = %q, want %q", v, "stopped")
+	}
+}
+
+// TestSignal_SubscribeAfterClose verifies subscribing to a closed signal registers nothing
+func TestSignal_SubscribeAfterClose(t *testing.T) {
+	sig := New(1)
+	sig.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	before := runtime.NumGoroutine()
+
+	var calls atomic.Int32
+	unsubs := []Unsubscribe{
+		sig.Subscribe(ctx, func(int) { calls.Add(1) }),
+		sig.SubscribeForever(func(int) { calls.Add(1) }),
+		sig.(PrioritySubscriber[int]).SubscribeWithPriority(ctx, 1, func(int) { calls.Add(1) }),
+	}
+	comp := Computed(func() int { return sig.Get() * 2 }, sig.AsReadonly())
+
+	if n := subscriberCount(sig); n != 0 {
+		t.Errorf("%d subscribers registered after Close, want 0", n)
+	}
+	if d := sig.Dependents(); d.Total() != 0 {
+		t.Errorf("Dependents() = %+v after Close, want none", d)
+	}
+	if got := comp.Get(); got != 2 {
+		t.Errorf("computed Get() = %d, want 2", got)
+	}
+
+	cancel()
+	sig.Set(5)
+	for _, unsub := range unsubs {
+		unsub() // No-ops
+	}
+	if n := calls.Load(); n != 0 {
+		t.Errorf("callbacks ran %d times, want 0", n)
+	}
+	if after := runtime.NumGoroutine(); after > before {
+		t.Errorf("goroutines grew from %d to %d", before, after)
+	}
+}
+
+// TestSignal_SubscribeNoGoroutines verifies subscriptions park no goroutines
+// and context cancellation still unsubscribes
+func TestSignal_SubscribeNoGoroutines(t *testing.T) {
+	sig := New(0)
+	ctx, cancel := context.WithCancel(context.Background())
+	before := runtime.NumGoroutine()
+
+	for i := 0; i < 100; i++ {
+		sig.SubscribeForever(func(int) {})
+		sig.Subscribe(ctx, func(int) {})
+	}
+
+	if after := runtime.NumGoroutine(); after > before {
+		t.Errorf("goroutines grew from %d to %d for 200 subscriptions", before, after)
+	}
+
+	cancel()
+	deadline := time.Now().Add(2 * time.Second)
+	for subscriberCount(sig) != 100 {
+		if time.Now().After(deadline) {
+			t.Fatalf("subscriber count = %d after cancel, want 100", subscriberCount(sig))
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+// TestSignal_LockFreeReads verifies the atomic value path behaves like the locked one
+func TestSignal_LockFreeReads(t *testing.T) {
+	sig := NewWithOptions(1, Options[int]{
+		LockFreeReads: true,
+		Equal:         func(a, b int) bool { return a == b },
+	})
+
+	var calls int32
+	defer sig.SubscribeForever(func(int) { atomic.AddInt32(&calls, 1) })()
+
+	if got := sig.Get(); got != 1 {
+		t.Fatalf("Get() = %d, want 1", got)
+	}
+	sig.Set(1) // Equal: no notification
+	sig.Set(2)
+	sig.Update(func(v int) int { return v * 10 })
+
+	if got := sig.Get(); got != 20 {
+		t.Errorf("Get() = %d, want 20", got)
+	}
+	if got := atomic.LoadInt32(&calls); got != 2 {
+		t.Errorf("subscriber called %d times, want 2", got)
+	}
+	if got := sig.Fork().Get(); got != 20 {
+		t.Errorf("Fork().Get() = %d, want 20", got)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func() { defer wg.Done(); sig.Update(func(v int) int { return v + 1 }) }()
+		go func() { defer wg.Done(); _ = sig.Get() }()
+	}
+	wg.Wait()
+	if got := sig.Get(); got != 30 {
+		t.Errorf("Get() after concurrent updates = %d, want 30", got)
+	}
+}
+
+// TestSignal_StrongConsistencyBlocksReaders verifies a Get waits for the write's subscribers in strong mode
+func TestSignal_StrongConsistencyBlocksReaders(t *testing.T) {
+	sig := NewWithOptions(0, Options[int]{StrongConsistency: true})
+
+	entered, release := make(chan struct{}), make(chan struct{})
+	var notified atomic.Bool
+	sig.SubscribeForever(func(int) {
+		close(entered)
+		<-release
+		notified.Store(true)
+	})
+
+	go sig.Set(1)
+	<-entered
+
+	read := make(chan int, 1)
+	go func() { read <- sig.Get() }()
+	select {
+	case v := <-read:
+		t.Fatalf("Get() = %d returned while a subscriber was being notified", v)
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	close(release)
+	if v := <-read; v != 1 {
+		t.Errorf("Get() = %d, want 1", v)
+	}
+	if !notified.Load() {
+		t.Error("Get() returned before the subscriber finished")
+	}
+}
+
+// TestSignal_StrongConsistencyConcurrent verifies no Get observes a value before its subscribers ran
+func TestSignal_StrongConsistencyConcurrent(t *testing.T) {
+	sig := NewWithOptions(0, Options[int]{StrongConsistency: true})
+	var notified atomic.Int64
+	sig.SubscribeForever(func(v int) { notified.Store(int64(v)) })
+	doubled := Computed(func() int { return sig.Get() * 2 }, sig.AsReadonly())
+
+	var wg sync.WaitGroup
+	var violations atomic.Int32
+	for range 4 {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for range 200 {
+				sig.Update(func(v int) int { return v + 1 })
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for range 200 {
+				if v := sig.Get(); int64(v) > notified.Load() {
+					violations.Add(1)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if n := violations.Load(); n != 0 {
+		t.Errorf("%d reads saw a value before its subscribers were notified", n)
+	}
+	if got := doubled.Get(); got != 1600 {
+		t.Errorf("doubled.Get() = %d, want 1600", got)
+	}
+	if got := sig.Fork().(*signal[int]).strong; !got {
+		t.Error("Fork() dropped StrongConsistency")
+	}
+}
+
+// TestSignal_UpdateAndGet verifies concurrent incrementers each see the value they committed
+func TestSignal_UpdateAndGet(t *testing.T) {
+	const n = 200
+	sig := New(0)
+
+	results := make(chan int, n)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results <- sig.(UpdateGetter[int]).UpdateAndGet(func(v int) int { return v + 1 })
+		}()
+	}
+	wg.Wait()
+	close(results)
+
+	seen := make(map[int]bool, n)
+	for v := range results {
+		if seen[v] {
+			t.Errorf("UpdateAndGet returned %d twice", v)
+		}
+		seen[v] = true
+	}
+	for i := 1; i <= n; i++ {
+		if !seen[i] {
+			t.Errorf("no UpdateAndGet returned %d", i)
+		}
+	}
+}
+
+// TestSignal_UpdateAndGetUnchanged verifies rejected and equal writes return the current value
+func TestSignal_UpdateAndGetUnchanged(t *testing.T) {
+	sig := NewWithOptions(5, Options[int]{
+		Equal: func(a, b int) bool { return a == b },
+		Validate: func(v int) error {
+			if v < 0 {
+				return errors.New("negative")
+			}
+			return nil
+		},
+	})
+
+	updater := sig.(UpdateGetter[int])
+	if got := updater.UpdateAndGet(func(int) int { return -1 }); got != 5 {
+		t.Errorf("rejected UpdateAndGet = %d, want 5", got)
+	}
+	if got := updater.UpdateAndGet(func(v int) int { return v }); got != 5 {
+		t.Errorf("equal UpdateAndGet = %d, want 5", got)
+	}
+}
+
+// TestSignal_CompareAndSwap verifies CAS succeeds only on a matching value
+func TestSignal_CompareAndSwap(t *testing.T) {
+	sig := New(1)
+	cas := sig.(CompareAndSwapper[int])
+
+	var calls int32
+	defer sig.SubscribeForever(func(int) { atomic.AddInt32(&calls, 1) })()
+
+	if cas.CompareAndSwap(2, 3) {
+		t.Error("CompareAndSwap(2, 3) on 1 succeeded, want failure")
+	}
+	if got := atomic.LoadInt32(&calls); got != 0 {
+		t.Errorf("failed CAS notified %d times, want 0", got)
+	}
+	if !cas.CompareAndSwap(1, 3) {
+		t.Error("CompareAndSwap(1, 3) on 1 failed, want success")
+	}
+	if got := sig.Get(); got != 3 {
+		t.Errorf("Get() = %d, want 3", got)
+	}
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Errorf("successful CAS notified %d times, want 1", got)
+	}
+}
+
+// TestSignal_CompareAndSwapConcurrent verifies exactly one of competing CAS calls wins
+func TestSignal_CompareAndSwapConcurrent(t *testing.T) {
+	sig := New(0)
+
+	var wins int32
+	var wg sync.WaitGroup
+	for i := 1; i <= 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if sig.(CompareAndSwapper[int]).CompareAndSwap(0, i) {
+				atomic.AddInt32(&wins, 1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := atomic.LoadInt32(&wins); got != 1 {
+		t.Errorf("%d CAS calls won, want exactly 1", got)
+	}
+	if sig.Get() == 0 {
+		t.Error("value unchanged after a winning CAS")
+	}
+}
+
+// TestSignal_ReplaceIf verifies the swap happens only when pred accepts the current value
+func TestSignal_ReplaceIf(t *testing.T) {
+	sig := New("queued")
+	var calls int
+	sig.SubscribeForever(func(string) { calls++ })
+
+	isQueued := func(s string) bool { return s == "queued" }
+	replacer := sig.(Replacer[string])
+	if !replacer.ReplaceIf(isQueued, "running") {
+		t.Error("ReplaceIf on a matching value failed")
+	}
+	if replacer.ReplaceIf(isQueued, "stolen") {
+		t.Error("ReplaceIf on a non-matching value succeeded")
+	}
+	if got := sig.Get(); got != "running" || calls != 1 {
+		t.Errorf("Get() = %q after %d notifications, want running after 1", got, calls)
+	}
+}
+
+// TestSignal_ReplaceIfConcurrent verifies exactly one ReplaceIf succeeds per matching state
+func TestSignal_ReplaceIfConcurrent(t *testing.T) {
+	sig := New(0)
+	even := func(v int) bool { return v%2 == 0 }
+
+	// Each round, only one goroutine may move the value from even to odd
+	var wins atomic.Int32
+	for round := range 10 {
+		var wg sync.WaitGroup
+		for range 20 {
+			wg.Go(func() {
+				if sig.(Replacer[int]).ReplaceIf(even, 2*round+1) {
+					wins.Add(1)
+				}
+			})
+		}
+		wg.Wait()
+		if got := wins.Load(); got != int32(round+1) {
+			t.Fatalf("round %d: %d wins in total, want %d", round, got, round+1)
+		}
+		sig.Set(2 * (round + 1))
+	}
+}
+
+// TestSignal_CompareAndSwapEqual verifies non-comparable types use Equal and require it
+func TestSignal_CompareAndSwapEqual(t *testing.T) {
+	sig := NewWithOptions([]int{1}, Options[[]int]{Equal: slices.Equal[[]int]})
+	if !sig.(CompareAndSwapper[[]int]).CompareAndSwap([]int{1}, []int{2}) {
+		t.Error("CompareAndSwap with Equal failed on matching content")
+	}
+	if got := sig.Get(); !slices.Equal(got, []int{2}) {
+		t.Errorf("Get() = %v, want [2]", got)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("CompareAndSwap on []int without Equal did not panic")
+		}
+	}()
+	New([]int{1}).(CompareAndSwapper[[]int]).CompareAndSwap(nil, nil)
+}
+
+// TestEqualBy verifies key-based equality suppresses notifications for same-key values
+func TestEqualBy(t *testing.T) {
+	type User struct {
+		ID   int
+		Name string
+	}
+
+	user := NewWithOptions(User{ID: 1, Name: "Alice"}, Options[User]{
+		Equal: EqualBy(func(u User) int { return u.ID }),
+	})
+
+	var calls int32
+	defer user.SubscribeForever(func(User) { atomic.AddInt32(&calls, 1) })()
+
+	user.Set(User{ID: 1, Name: "Alicia"})
+	if got := atomic.LoadInt32(&calls); got != 0 {
+		t.Errorf("same ID notified %d times, want 0", got)
+	}
+	if got := user.Get().Name; got != "Alice" {
+		t.Errorf("Name = %q after same-ID Set, want %q (write suppressed)", got, "Alice")
+	}
+
+	user.Set(User{ID: 2, Name: "Bob"})
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Errorf("new ID notified %d times, want 1", got)
+	}
+}
+
+// TestFromOptions verifies a signal built from Options alone starts at Initial and applies the rest
+func TestFromOptions(t *testing.T) {
+	opts := Options[int]{Initial: 3, Equal: func(a, b int) bool { return a == b }}
+	sig := FromOptions(opts)
+	if got := sig.Get(); got != 3 {
+		t.Errorf("Get() = %d, want 3", got)
+	}
+
+	var calls int
+	sig.SubscribeForever(func(int) { calls++ })
+	sig.Set(3)
+	if calls != 0 {
+		t.Errorf("Set(Initial) notified %d times, want 0 (Equal applied)", calls)
+	}
+	if got := FromOptions(Options[string]{}).Get(); got != "" {
+		t.Errorf("zero Options: Get() = %q, want empty", got)
+	}
+}
+
+// TestMustNew verifies MustNew panics on invalid options and accepts valid ones
+func TestMustNew(t *testing.T) {
+	nonNegative := func(v int) error {
+		if v < 0 {
+			return errors.New("negative")
+		}
+		return nil
+	}
+
+	tests := []struct {
+		name    string
+		initial int
+		opts    Options[int]
+		wantErr bool
+	}{
+		{"zero options", 0, Options[int]{}, false},
+		{"validate with handler", 1, Options[int]{Validate: nonNegative, OnRejected: func(int, error) {}}, false},
+		{"OnRejected without Validate", 0, Options[int]{OnRejected: func(int, error) {}}, true},
+		{"nil interceptor", 0, Options[int]{Interceptors: []func(old, new int) int{nil}}, true},
+		{"invalid initial value", -1, Options[int]{Validate: nonNegative}, true},
+		{"negative MaxSubscribers", 0, Options[int]{MaxSubscribers: -1}, true},
+		{"OnLimit without MaxSubscribers", 0, Options[int]{OnLimit: func(error) {}}, true},
+		{"OnSlow without threshold", 0, Options[int]{OnSlow: func(SlowSubscriber) {}}, true},
+		{"MaxAge without Refresh", 0, Options[int]{MaxAge: time.Second}, true},
+		{"Refresh without MaxAge", 0, Options[int]{Refresh: func() (int, error) { return 0, nil }}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				r := recover()
+				if !tt.wantErr {
+					if r != nil {
+						t.Errorf("MustNew panicked: %v", r)
+					}
+					return
+				}
+				err, ok := r.(error)
+				if !ok || !errors.Is(err, ErrInvalidOptions) {
+					t.Errorf("MustNew panic = %v, want ErrInvalidOptions", r)
+				}
+			}()
+			sig := MustNew(tt.initial, tt.opts)
+			if got := sig.Get(); got != tt.initial {
+				t.Errorf("Get() = %d, want %d", got, tt.initial)
+			}
+		})
+	}
+}
+
+// TestSignal_FuncValueAlwaysNotifies verifies func-valued signals work without Equal
+func TestSignal_FuncValueAlwaysNotifies(t *testing.T) {
+	handler := func() int { return 1 }
+	sig := New(handler)
+
+	var notifications int
+	sig.SubscribeForever(func(func() int) { notifications++ })
+
+	sig.Set(handler)
+	sig.Set(func() int { return 2 })
+
+	if notifications != 2 {
+		t.Errorf("notifications = %d, want 2", notifications)
+	}
+	if got := sig.Get()(); got != 2 {
+		t.Errorf("Get()() = %d, want 2", got)
+	}
+}
+
+// TestIdentityEqual_Funcs verifies IdentityEqual compares funcs by identity without panicking
+func TestIdentityEqual_Funcs(t *testing.T) {
+	makeHandler := func(n int) func() int { return func() int { return n } }
+	first := makeHandler(1)
+
+	sig := NewWithOptions(first, Options[func() int]{Equal: IdentityEqual[func() int]()})
+	var notifications int
+	sig.SubscribeForever(func(func() int) { notifications++ })
+
+	sig.Set(first)          // Same func: no notification
+	sig.Set(makeHandler(2)) // Same literal, different closure: notifies
+	sig.Set(nil)            // Notifies
+	sig.Set(nil)            // Same nil: no notification
+
+	if notifications != 2 {
+		t.Errorf("notifications = %d, want 2", notifications)
+	}
+}
+
+// TestIdentityEqual_ReferenceTypes verifies identity semantics for maps, slices, and interfaces
+func TestIdentityEqual_ReferenceTypes(t *testing.T) {
+	m := map[string]int{"a": 1}
+	mapEq := IdentityEqual[map[string]int]()
+	if !mapEq(m, m) || mapEq(m, map[string]int{"a": 1}) {
+		t.Error("maps should be equal only to themselves")
+	}
+
+	s := []int{1, 2, 3}
+	sliceEq := IdentityEqual[[]int]()
+	if !sliceEq(s, s) || sliceEq(s, s[:2]) || sliceEq(s, []int{1, 2, 3}) {
+		t.Error("slices should be equal only with the same array and length")
+	}
+
+	f := func() {}
+	anyEq := IdentityEqual[any]()
+	if !anyEq(f, f) || anyEq(f, func() {}) || anyEq(f, nil) || !anyEq(nil, nil) {
+		t.Error("funcs in interfaces should compare by identity")
+	}
+	if !anyEq(1, 1) || anyEq(1, 2) || anyEq(1, "1") {
+		t.Error("comparable values in interfaces should compare with ==")
+	}
+	if !anyEq(s, s) {
+		t.Error("slices in interfaces should compare by identity")
+	}
+	if anyEq(struct{ f func() }{f}, struct{ f func() }{f}) {
+		t.Error("non-comparable structs should never be equal")
+	}
+}
+
+// TestSignal_CompareAndSwapUncomparableDynamic verifies CAS on any holding a func doesn't panic
+func TestSignal_CompareAndSwapUncomparableDynamic(t *testing.T) {
+	f := func() {}
+	sig := New[any](f)
+	cas := sig.(CompareAndSwapper[any])
+
+	if cas.CompareAndSwap(f, 1) {
+		t.Error("CompareAndSwap matched a func value, want no match")
+	}
+
+	sig.Set(1)
+	if !cas.CompareAndSwap(1, 2) || sig.Get() != 2 {
+		t.Errorf("CompareAndSwap(1, 2) failed, Get() = %v", sig.Get())
+	}
+}
+
+// TestSignal_CompareAndSwapUncomparableField verifies CAS on structs and arrays holding a slice in an interface doesn't panic
+func TestSignal_CompareAndSwapUncomparableField(t *testing.T) {
+	type boxed struct {
+		Name string
+		V    any
+	}
+	sig := New(boxed{V: []int{1}})
+	cas := sig.(CompareAndSwapper[boxed])
+	if cas.CompareAndSwap(boxed{V: []int{1}}, boxed{}) {
+		t.Error("CompareAndSwap matched a slice field, want no match")
+	}
+
+	sig.Set(boxed{Name: "a", V: 1})
+	if !cas.CompareAndSwap(boxed{Name: "a", V: 1}, boxed{Name: "b"}) || sig.Get().Name != "b" {
+		t.Errorf("CompareAndSwap of comparable contents failed, Get() = %v", sig.Get())
+	}
+
+	arr := New([2]any{1, []int{2}})
+	if arr.(CompareAndSwapper[[2]any]).CompareAndSwap([2]any{1, []int{2}}, [2]any{}) {
+		t.Error("CompareAndSwap matched an array holding a slice, want no match")
+	}
+}
+
+// TestSignal_Observe verifies Observe calls fn immediately and on each change until unsubscribed
+func TestSignal_Observe(t *testing.T) {
+	sig := New(1)
+
+	var got []int
+	stop := sig.Observe(func(v int) { got = append(got, v) })
+	if !slices.Equal(got, []int{1}) {
+		t.Fatalf("after Observe got %v, want [1]", got)
+	}
+
+	sig.Set(2)
+	sig.Set(3)
+	stop()
+	sig.Set(4)
+
+	if want := []int{1, 2, 3}; !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if n := subscriberCount(sig); n != 0 {
+		t.Errorf("subscriberCount = %d after stop, want 0", n)
+	}
+}
+
+// TestSignal_ObserveSubscribesInOrder verifies Observe sees writes made by its own initial call
+func TestSignal_ObserveSubscribesInOrder(t *testing.T) {
+	sig := New(0)
+
+	var got []int
+	stop := sig.Observe(func(v int) {
+		got = append(got, v)
+		if v == 0 {
+			sig.Set(1) // Already subscribed: must be delivered
+		}
+	})
+	defer stop()
+
+	if want := []int{0, 1}; !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+// TestSignal_ObserveConcurrentWriter verifies a write from another goroutine during the initial call is delivered after it, not concurrently
+func TestSignal_ObserveConcurrentWriter(t *testing.T) {
+	sig := New(0)
+
+	var running atomic.Int32
+	var mu sync.Mutex
+	var got []int
+	stop := sig.Observe(func(v int) {
+		if running.Add(1) != 1 {
+			t.Error("fn ran concurrently with itself")
+		}
+		defer running.Add(-1)
+		mu.Lock()
+		got = append(got, v)
+		mu.Unlock()
+		if v == 0 {
+			written := make(chan struct{})
+			go func() {
+				sig.Set(1)
+				close(written)
+			}()
+			<-written
+		}
+	})
+	defer stop()
+
+	mu.Lock()
+	defer mu.Unlock()
+	if want := []int{0, 1}; !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+// TestSignal_ObservePanicRecovered verifies a panic in the initial call is recovered and reported like a subscriber panic
+func TestSignal_ObservePanicRecovered(t *testing.T) {
+	var reported []any
+	sig := NewWithOptions(0, Options[int]{
+		OnPanic: func(err any, _ []byte) { reported = append(reported, err) },
+	})
+
+	var got []int
+	stop := sig.Observe(func(v int) {
+		got = append(got, v)
+		if v == 0 {
+			panic("initial")
+		}
+	})
+	defer stop()
+	sig.Set(1)
+
+	if len(reported) != 1 || reported[0] != "initial" {
+		t.Errorf("reported %v, want [initial]", reported)
+	}
+	if want := []int{0, 1}; !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+// TestSignal_OnTimingNotify verifies OnTiming reports the duration of a slow notification
+func TestSignal_OnTimingNotify(t *testing.T) {
+	const slow = 20 * time.Millisecond
+
+	var mu sync.Mutex
+	timings := map[string][]time.Duration{}
+	sig := NewWithOptions(0, Options[int]{
+		OnTiming: func(op string, d time.Duration) {
+			mu.Lock()
+			timings[op] = append(timings[op], d)
+			mu.Unlock()
+		},
+	})
+	sig.SubscribeForever(func(int) { time.Sleep(slow) })
+
+	sig.Set(1)
+	sig.Update(func(v int) int { return v + 1 })
+
+	mu.Lock()
+	defer mu.Unlock()
+	notify := timings[TimingNotify]
+	if len(notify) != 2 {
+		t.Fatalf("got %d notify timings, want 2 (timings = %v)", len(notify), timings)
+	}
+	for _, d := range notify {
+		if d < slow {
+			t.Errorf("notify took %v, want at least %v", d, slow)
+		}
+	}
+}
+
+// TestSignal_OnTimingForked verifies Fork keeps the OnTiming hook
+func TestSignal_OnTimingForked(t *testing.T) {
+	var calls atomic.Int32
+	sig := NewWithOptions(0, Options[int]{
+		OnTiming: func(string, time.Duration) { calls.Add(1) },
+	})
+
+	sig.Fork().Set(1)
+	if calls.Load() != 1 {
+		t.Errorf("OnTiming calls = %d, want 1", calls.Load())
+	}
+}
+
+// TestSignal_SubscribeWithPriority verifies higher-priority subscribers run first, ties in subscription order
+func TestSignal_SubscribeWithPriority(t *testing.T) {
+	sig := New(0)
+	var order []string
+	record := func(name string) func(int) {
+		return func(int) { order = append(order, name) }
+	}
+
+	prio := sig.(PrioritySubscriber[int])
+	prio.SubscribeWithPriority(context.Background(), -5, record("last"))
+	sig.SubscribeForever(record("plain"))
+	prio.SubscribeWithPriority(context.Background(), 10, record("cache"))
+	prio.SubscribeWithPriority(context.Background(), 1, record("first-1"))
+	prio.SubscribeWithPriority(context.Background(), 1, record("second-1"))
+
+	sig.Set(1)
+
+	want := []string{"cache", "first-1", "second-1", "plain", "last"}
+	if !slices.Equal(order, want) {
+		t.Errorf("order = %v, want %v", order, want)
+	}
+}
+
+// TestSignal_SubscribeWithPriorityUnsubscribe verifies prioritized subscribers can be removed
+func TestSignal_SubscribeWithPriorityUnsubscribe(t *testing.T) {
+	sig := New(0)
+	ctx, cancel := context.WithCancel(context.Background())
+	var calls atomic.Int32
+	prio := sig.(PrioritySubscriber[int])
+	unsub := prio.SubscribeWithPriority(context.Background(), 1, func(int) { calls.Add(1) })
+	prio.SubscribeWithPriority(ctx, 2, func(int) { calls.Add(1) })
+
+	sig.Set(1)
+	unsub()
+	cancel()
+	waitFor(t, func() bool { return subscriberCount(sig) == 0 })
+	sig.Set(2)
+
+	if got := calls.Load(); got != 2 {
+		t.Errorf("calls = %d, want 2", got)
+	}
+}
+
+// TestSignal_SetWithMeta verifies meta reaches SubscribeMeta subscribers, plain subscribers get only the value, and Set delivers nil
+func TestSignal_SetWithMeta(t *testing.T) {
+	sig := New("")
+	type delivery struct {
+		value string
+		meta  any
+	}
+	var withMeta []delivery
+	var plain []string
+	sig.(MetaSubscriber[string]).SubscribeMeta(context.Background(), func(v string, meta any) {
+		withMeta = append(withMeta, delivery{v, meta})
+	})
+	sig.SubscribeForever(func(v string) { plain = append(plain, v) })
+
+	sig.(MetaSetter[string]).SetWithMeta("a", "client-1")
+	sig.Set("b")
+
+	if want := []delivery{{"a", "client-1"}, {"b", nil}}; !slices.Equal(withMeta, want) {
+		t.Errorf("SubscribeMeta saw %v, want %v", withMeta, want)
+	}
+	if want := []string{"a", "b"}; !slices.Equal(plain, want) {
+		t.Errorf("Subscribe saw %v, want %v", plain, want)
+	}
+}
+
+// TestSignal_SetWithMetaQueued verifies a write-back made during delivery keeps its own meta, with and without interceptors
+func TestSignal_SetWithMetaQueued(t *testing.T) {
+	for _, opts := range []Options[int]{{}, {Interceptors: []func(old, new int) int{func(_, v int) int { return v }}}} {
+		sig := NewWithOptions(0, opts)
+		setter := sig.(MetaSetter[int])
+		var metas []any
+		sig.(MetaSubscriber[int]).SubscribeMeta(context.Background(), func(v int, meta any) {
+			metas = append(metas, meta)
+			if v == 1 {
+				setter.SetWithMeta(2, "echo")
+			}
+		})
+
+		setter.SetWithMeta(1, "origin")
+
+		if want := []any{"origin", "echo"}; !slices.Equal(metas, want) {
+			t.Errorf("interceptors=%d: metas = %v, want %v", len(opts.Interceptors), metas, want)
+		}
+	}
+}
+
+// TestSignal_SetEqual verifies swapping Equal at runtime changes which writes notify
+func TestSignal_SetEqual(t *testing.T) {
+	sig := New(1.0)
+	var notified []float64
+	sig.SubscribeForever(func(v float64) { notified = append(notified, v) })
+
+	sig.Set(1.0) // No Equal: always notifies
+	sig.SetEqual(func(a, b float64) bool { return math.Abs(a-b) < 0.5 })
+	sig.Set(1.2) // Loose: unchanged
+	sig.Set(2.0)
+	sig.SetEqual(nil)
+	sig.Set(2.0)
+
+	if want := []float64{1.0, 2.0, 2.0}; !slices.Equal(notified, want) {
+		t.Errorf("notified %v, want %v", notified, want)
+	}
+	if got := sig.Get(); got != 2.0 {
+		t.Errorf("Get() = %v, want 2", got)
+	}
+}
+
+// TestSignal_SetEqualConcurrent verifies SetEqual is safe alongside writes
+func TestSignal_SetEqualConcurrent(t *testing.T) {
+	sig := NewComparable(0)
+	strict := func(a, b int) bool { return a == b }
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := range 200 {
+			sig.Set(i % 3)
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := range 200 {
+			if i%2 == 0 {
+				sig.SetEqual(nil)
+			} else {
+				sig.SetEqual(strict)
+			}
+		}
+	}()
+	wg.Wait()
+
+	if fork := sig.Fork(); fork.Get() != sig.Get() {
+		t.Errorf("fork value = %d, want %d", fork.Get(), sig.Get())
+	}
+}
+
+// TestSignal_SubscribeDuringNotify verifies a subscription made by a subscriber takes effect on the next Set
+func TestSignal_SubscribeDuringNotify(t *testing.T) {
+	sig := New(0)
+	var mu sync.Mutex
+	var late []int
+	var once sync.Once
+
+	sig.SubscribeForever(func(int) {
+		once.Do(func() {
+			sig.SubscribeForever(func(v int) {
+				mu.Lock()
+				late = append(late, v)
+				mu.Unlock()
+			})
+		})
+	})
+	sig.(PrioritySubscriber[int]).SubscribeWithPriority(context.Background(), 1, func(int) {})
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		sig.Set(1)
+		sig.Set(2)
+		sig.Set(3)
+	}()
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("deadlock: subscribing during notification blocked")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if want := []int{2, 3}; !slices.Equal(late, want) {
+		t.Errorf("late subscriber saw %v, want %v (not the value being delivered)", late, want)
+	}
+}
+
+// TestSignal_MaxSubscribers verifies subscriptions beyond the limit are rejected and reported
+func TestSignal_MaxSubscribers(t *testing.T) {
+	var limitErrs []error
+	sig := NewWithOptions(0, Options[int]{
+		MaxSubscribers: 2,
+		OnLimit:        func(err error) { limitErrs = append(limitErrs, err) },
+	})
+
+	var calls atomic.Int32
+	count := func(int) { calls.Add(1) }
+	unsub := sig.SubscribeForever(count)
+	sig.Subscribe(context.Background(), count)
+	sig.SubscribeForever(count)                                                         // Rejected
+	sig.(PrioritySubscriber[int]).SubscribeWithPriority(context.Background(), 1, count) // Rejected
+
+	if n := subscriberCount(sig); n != 2 {
+		t.Errorf("%d subscribers registered, want 2", n)
+	}
+	if len(limitErrs) != 2 || !errors.Is(limitErrs[0], ErrSubscriberLimit) {
+		t.Errorf("OnLimit got %v, want 2 ErrSubscriberLimit", limitErrs)
+	}
+	sig.Set(1)
+	if n := calls.Load(); n != 2 {
+		t.Errorf("%d callbacks ran, want 2", n)
+	}
+
+	// Freed slots can be reused
+	unsub()
+	sig.SubscribeForever(count)
+	if n := subscriberCount(sig); n != 2 || len(limitErrs) != 2 {
+		t.Errorf("after unsubscribe: %d subscribers, %d rejections, want 2 and 2", n, len(limitErrs))
+	}
+}
+
+// TestSignal_MaxSubscribersLogged verifies rejections are logged without OnLimit
+func TestSignal_MaxSubscribersLogged(t *testing.T) {
+	h := &captureHandler{}
+	sig := NewWithOptions("", Options[string]{MaxSubscribers: 1, Logger: slog.New(h)})
+	sig.SubscribeForever(func(string) {})
+	sig.SubscribeForever(func(string) {})
+
+	if r := h.only(t); !strings.Contains(r.Message, "subscriber limit") {
+		t.Errorf("logged %q, want the subscriber limit error", r.Message)
+	}
+}
+
+// TestSignal_RePanicOn verifies panics selected by RePanicOn propagate from Set, after being reported
+func TestSignal_RePanicOn(t *testing.T) {
+	var reported []any
+	sig := NewWithOptions(0, Options[int]{
+		OnPanic: func(r any, _ []byte) { reported = append(reported, r) },
+		RePanicOn: func(r any) bool {
+			_, fatal := r.(runtime.Error)
+			return fatal
+		},
+	})
+	var later int
+	sig.(PrioritySubscriber[int]).SubscribeWithPriority(context.Background(), 1, func(v int) { // Runs first
+		if v == 1 {
+			var m map[string]int
+			m["x"] = v // Fatal: nil map write
+		}
+	})
+	sig.SubscribeForever(func(int) { later++ })
+
+	func() {
+		defer func() {
+			if _, ok := recover().(runtime.Error); !ok {
+				t.Error("Set did not propagate the runtime error")
+			}
+		}()
+		sig.Set(1)
+	}()
+	if len(reported) != 1 {
+		t.Errorf("OnPanic called %d times before propagating, want 1", len(reported))
+	}
+
+	sig.Set(2) // Delivery state was reset
+	if later != 1 {
+		t.Errorf("second subscriber called %d times, want 1 (skipped on re-panic, then notified)", later)
+	}
+}
+
+// TestSignal_RePanicOnSwallowed verifies panics RePanicOn rejects stay isolated
+func TestSignal_RePanicOnSwallowed(t *testing.T) {
+	var reported int
+	sig := NewWithOptions(0, Options[int]{
+		OnPanic:   func(any, []byte) { reported++ },
+		RePanicOn: func(r any) bool { return r == "fatal" },
+	})
+	var later int
+	sig.SubscribeForever(func(int) { panic("benign") })
+	sig.SubscribeForever(func(int) { later++ })
+
+	sig.Set(1) // Must not panic
+	if reported != 1 || later != 1 {
+		t.Errorf("reported %d, second subscriber called %d times, want 1 and 1", reported, later)
+	}
+	if fork := sig.Fork().(*signal[int]); fork.rePanicOn == nil {
+		t.Error("fork lost RePanicOn")
+	}
+}
+
+// TestSignal_SubscriberCountSignal verifies the count follows Subscribe, Unsubscribe, context cancellation, and Close
+func TestSignal_SubscriberCountSignal(t *testing.T) {
+	sig := New(0)
+	unsubFirst := sig.SubscribeForever(func(int) {})
+
+	count := sig.SubscriberCountSignal()
+	var counts []int
+	count.SubscribeForever(func(n int) { counts = append(counts, n) })
+	if n := count.Get(); n != 1 {
+		t.Errorf("initial count = %d, want 1", n)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	sig.Subscribe(ctx, func(int) {})
+	sig.(PrioritySubscriber[int]).SubscribeWithPriority(context.Background(), 1, func(int) {})
+	cancel()
+	waitFor(t, func() bool { return count.Get() == 2 })
+	unsubFirst()
+	unsubFirst() // Idempotent, no change
+	sig.Close()
+
+	if want := []int{2, 3, 2, 1, 0}; !slices.Equal(counts, want) {
+		t.Errorf("counts = %v, want %v", counts, want)
+	}
+	if sig.SubscriberCountSignal().Get() != 0 {
+		t.Error("SubscriberCountSignal() not shared between calls")
+	}
+}
+
+// TestSignal_SubscriberCountSignalConcurrent verifies the count settles right under concurrent subscriptions
+func TestSignal_SubscriberCountSignalConcurrent(t *testing.T) {
+	sig := New(0)
+	count := sig.SubscriberCountSignal()
+
+	var wg sync.WaitGroup
+	for range 50 {
+		wg.Go(func() {
+			unsub := sig.SubscribeForever(func(int) {})
+			if subscriberCount(sig)%2 == 0 {
+				unsub()
+			}
+		})
+	}
+	wg.Wait()
+
+	if got, want := count.Get(), subscriberCount(sig); got != want {
+		t.Errorf("count = %d, want %d", got, want)
+	}
+}
@@ -0,0 +1,169 @@
+package signals
+
+import (
+	"context"
+	"sync"
+)
+
+// SetChange describes a change to a member of a SignalSet.
+type SetChange[K comparable, V any] struct {
+	// Key identifies the member.
+	Key K
+
+	// Value is the member's new value, or its last value if Deleted.
+	Value V
+
+	// Deleted reports that the member was removed by Delete.
+	Deleted bool
+}
+
+// SignalSet is a reactive keyed store: a collection of same-typed signals
+// created on demand by key, with a single stream reporting changes to any
+// of them. It suits entity stores, where each entity is a signal of its own
+// but some consumers want every change.
+//
+// Example:
+//
+//	users := signals.NewSignalSet[int, User]()
+//	users.GetOrCreate(42).Set(User{Name: "Ada"})
+//
+//	for c := range users.Changes(ctx) {
+//	    fmt.Printf("user %d: %+v (deleted: %v)\n", c.Key, c.Value, c.Deleted)
+//	}
+type SignalSet[K comparable, V any] interface {
+	// GetOrCreate returns the signal stored under key, creating it with the
+	// zero value of V if there is none.
+	GetOrCreate(key K) Signal[V]
+
+	// Delete removes the signal stored under key, if any, and closes it:
+	// its subscribers, computeds, and effects are removed and later writes
+	// to it are no-ops. Changes reports the removal with Deleted set.
+	Delete(key K)
+
+	// Keys returns the keys of the stored signals, in no particular order.
+	Keys() []K
+
+	// Changes returns a channel receiving a SetChange for each change to a
+	// member, until ctx is done; then the channel is closed. Changes are
+	// buffered up to SignalSetBufferSize; beyond it, the member's writer
+	// blocks until the channel is read or ctx is done.
+	Changes(ctx context.Context) <-chan SetChange[K, V]
+}
+
+// SignalSetBufferSize is the number of changes a SignalSet's Changes
+// channel buffers before writers to its members block.
+const SignalSetBufferSize = 64
+
+// signalSet is the internal implementation of SignalSet[K, V].
+type signalSet[K comparable, V any] struct {
+	// members holds the stored signals with their relay to changes
+	members map[K]setMember[V]
+
+	// mu protects members
+	mu sync.Mutex
+
+	// changes broadcasts member changes to Changes channels
+	changes *signal[SetChange[K, V]]
+}
+
+// setMember is a signal stored in a SignalSet.
+type setMember[V any] struct {
+	signal Signal[V]
+
+	// relay forwards the signal's changes to the set's changes
+	relay Unsubscribe
+}
+
+// NewSignalSet creates an empty SignalSet.
+func NewSignalSet[K comparable, V any]() SignalSet[K, V] {
+	return &signalSet[K, V]{
+		members: make(map[K]setMember[V]),
+		changes: newSignal(SetChange[K, V]{}, Options[SetChange[K, V]]{}),
+	}
+}
+
+// GetOrCreate returns the signal stored under key, creating it if needed.
+func (ss *signalSet[K, V]) GetOrCreate(key K) Signal[V] {
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+	if m, ok := ss.members[key]; ok {
+		return m.signal
+	}
+
+	var zero V
+	s := New(zero)
+	relay := s.SubscribeForever(func(v V) {
+		ss.changes.Set(SetChange[K, V]{Key: key, Value: v})
+	})
+	ss.members[key] = setMember[V]{signal: s, relay: relay}
+	return s
+}
+
+// Delete removes and closes the signal stored under key, then reports it.
+func (ss *signalSet[K, V]) Delete(key K) {
+	ss.mu.Lock()
+	m, ok := ss.members[key]
+	delete(ss.members, key)
+	ss.mu.Unlock()
+	if !ok {
+		return
+	}
+
+	m.relay()
+	m.signal.Close()
+	ss.changes.Set(SetChange[K, V]{Key: key, Value: m.signal.Get(), Deleted: true})
+}
+
+// Keys returns the keys of the stored signals.
+func (ss *signalSet[K, V]) Keys() []K {
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+	keys := make([]K, 0, len(ss.members))
+	for key := range ss.members {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// Changes returns a channel of member changes that closes once ctx is done.
+func (ss *signalSet[K, V]) Changes(ctx context.Context) <-chan SetChange[K, V] {
+	st := &setStream[K, V]{ch: make(chan SetChange[K, V], SignalSetBufferSize)}
+	unsub := ss.changes.Subscribe(ctx, func(c SetChange[K, V]) {
+		st.send(ctx, c)
+	})
+	context.AfterFunc(ctx, func() {
+		unsub()
+		st.close()
+	})
+	return st.ch
+}
+
+// setStream is the state of a Changes channel.
+type setStream[K comparable, V any] struct {
+	ch chan SetChange[K, V]
+
+	// mu protects closed; send holds it for reading so close can't race it
+	mu     sync.RWMutex
+	closed bool
+}
+
+// send delivers c, blocking while the buffer is full until ctx is done.
+func (st *setStream[K, V]) send(ctx context.Context, c SetChange[K, V]) {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+	if st.closed {
+		return
+	}
+	select {
+	case st.ch <- c:
+	case <-ctx.Done():
+	}
+}
+
+// close closes the channel once pending sends have given up.
+func (st *setStream[K, V]) close() {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	st.closed = true
+	close(st.ch)
+}
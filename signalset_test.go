@@ -0,0 +1,117 @@
+package signals
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+)
+
+// TestSignalSet_GetOrCreate verifies a key maps to a single signal, created with the zero value
+func TestSignalSet_GetOrCreate(t *testing.T) {
+	set := NewSignalSet[string, int]()
+
+	a := set.GetOrCreate("a")
+	if got := a.Get(); got != 0 {
+		t.Errorf("new member Get() = %d, want 0", got)
+	}
+	a.Set(1)
+	if got := set.GetOrCreate("a").Get(); got != 1 {
+		t.Errorf("GetOrCreate(a).Get() = %d, want 1 (same signal)", got)
+	}
+	set.GetOrCreate("b")
+
+	keys := set.Keys()
+	slices.Sort(keys)
+	if want := []string{"a", "b"}; !slices.Equal(keys, want) {
+		t.Errorf("Keys() = %v, want %v", keys, want)
+	}
+}
+
+// TestSignalSet_Changes verifies the Changes stream reports member updates and deletions with their keys
+func TestSignalSet_Changes(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	set := NewSignalSet[string, int]()
+	changes := set.Changes(ctx)
+
+	set.GetOrCreate("a").Set(1)
+	set.GetOrCreate("b").Set(2)
+	set.GetOrCreate("a").Set(3)
+	set.Delete("b")
+	set.Delete("missing") // No-op
+
+	want := []SetChange[string, int]{
+		{Key: "a", Value: 1},
+		{Key: "b", Value: 2},
+		{Key: "a", Value: 3},
+		{Key: "b", Value: 2, Deleted: true},
+	}
+	for i, w := range want {
+		select {
+		case got := <-changes:
+			if got != w {
+				t.Errorf("change %d = %+v, want %+v", i, got, w)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("change %d not received", i)
+		}
+	}
+	select {
+	case got := <-changes:
+		t.Errorf("unexpected change %+v", got)
+	default:
+	}
+}
+
+// TestSignalSet_DeleteCleansUp verifies Delete removes the member's subscribers and detaches it from the set
+func TestSignalSet_DeleteCleansUp(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	set := NewSignalSet[int, string]()
+	member := set.GetOrCreate(1)
+	var calls int
+	member.SubscribeForever(func(string) { calls++ })
+
+	set.Delete(1)
+	changes := set.Changes(ctx)
+	member.Set("late")
+
+	if calls != 0 {
+		t.Errorf("subscriber called %d times after Delete, want 0", calls)
+	}
+	if n := subscriberCount(member); n != 0 {
+		t.Errorf("member has %d subscribers after Delete, want 0", n)
+	}
+	if keys := set.Keys(); len(keys) != 0 {
+		t.Errorf("Keys() = %v after Delete, want none", keys)
+	}
+	select {
+	case got := <-changes:
+		t.Errorf("deleted member reported change %+v", got)
+	default:
+	}
+	if got := set.GetOrCreate(1).Get(); got != "" {
+		t.Errorf("recreated member Get() = %q, want a fresh zero value", got)
+	}
+}
+
+// TestSignalSet_ChangesClosesOnCancel verifies the Changes channel closes once its context is done
+func TestSignalSet_ChangesClosesOnCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	set := NewSignalSet[string, int]()
+	changes := set.Changes(ctx)
+	cancel()
+
+	select {
+	case _, ok := <-changes:
+		if ok {
+			t.Error("received a change after cancel, want a closed channel")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("channel not closed after cancel")
+	}
+	set.GetOrCreate("a").Set(1) // Must not send on the closed channel
+}
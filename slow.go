@@ -0,0 +1,100 @@
+package signals
+
+import (
+	"context"
+	"log"
+	"log/slog"
+	"reflect"
+	"runtime"
+	"runtime/debug"
+	"time"
+)
+
+// SlowSubscriber describes a subscriber callback that ran longer than
+// Options.SlowSubscriberThreshold.
+type SlowSubscriber struct {
+	// Duration is how long the callback ran.
+	Duration time.Duration
+
+	// Threshold is the SlowSubscriberThreshold it exceeded.
+	Threshold time.Duration
+
+	// Func names the callback as the runtime does, e.g.,
+	// "main.(*Cache).Update-fm" or "main.main.func1".
+	Func string
+
+	// Stack is the stack of the goroutine that delivered the notification,
+	// which leads back to the write that triggered it.
+	Stack []byte
+}
+
+// slowWatch times subscriber callbacks against Options.SlowSubscriberThreshold.
+type slowWatch struct {
+	threshold time.Duration
+	onSlow    func(SlowSubscriber)
+	logger    *slog.Logger
+}
+
+// newSlowWatch returns a slowWatch for Options, or nil if no threshold is set.
+func newSlowWatch[T any](opts Options[T]) *slowWatch {
+	if opts.SlowSubscriberThreshold <= 0 {
+		return nil
+	}
+	return &slowWatch{
+		threshold: opts.SlowSubscriberThreshold,
+		onSlow:    opts.OnSlow,
+		logger:    opts.Logger,
+	}
+}
+
+// watchSlow returns fn wrapped to report runs slower than w's threshold,
+// or fn itself if w is nil.
+func watchSlow[T any](w *slowWatch, fn func(T)) func(T) {
+	if w == nil {
+		return fn
+	}
+	name := funcName(fn)
+	return func(v T) {
+		defer w.check(name, time.Now())
+		fn(v)
+	}
+}
+
+// check reports callback name if it ran longer than the threshold since
+// start, panicking or not. Meant to be deferred with start set to time.Now().
+func (w *slowWatch) check(name string, start time.Time) {
+	d := time.Since(start)
+	if d <= w.threshold {
+		return
+	}
+	slow := SlowSubscriber{Duration: d, Threshold: w.threshold, Func: name, Stack: debug.Stack()}
+	if w.onSlow != nil {
+		w.onSlow(slow)
+		return
+	}
+	logSlow(w.logger, slow)
+}
+
+// funcName returns the runtime name of fn.
+func funcName(fn any) string {
+	if f := runtime.FuncForPC(reflect.ValueOf(fn).Pointer()); f != nil {
+		return f.Name()
+	}
+	return "unknown"
+}
+
+// logSlow reports a slow subscriber that has no OnSlow handler, to logger
+// at warn level or to the standard log package if nil.
+func logSlow(logger *slog.Logger, slow SlowSubscriber) {
+	if logger == nil {
+		log.Printf("signals: slow subscriber %s took %v (threshold %v)\n%s",
+			slow.Func, slow.Duration, slow.Threshold, slow.Stack)
+		return
+	}
+	logger.LogAttrs(context.Background(), slog.LevelWarn, "signals: slow subscriber",
+		slog.String("func", slow.Func),
+		slog.Duration("duration", slow.Duration),
+		slog.Duration("threshold", slow.Threshold),
+		slog.String("stack", string(slow.Stack)),
+	)
+}
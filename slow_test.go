@@ -0,0 +1,83 @@
+package signals
+
+import (
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+)
+
+// slowSubscriber sleeps past the thresholds used in these tests.
+func slowSubscriber(int) { time.Sleep(20 * time.Millisecond) }
+
+// TestSlowSubscriber_OnSlow verifies callbacks exceeding the threshold are reported with timing and stack
+func TestSlowSubscriber_OnSlow(t *testing.T) {
+	var got []SlowSubscriber
+	sig := NewWithOptions(0, Options[int]{
+		SlowSubscriberThreshold: 5 * time.Millisecond,
+		OnSlow:                  func(s SlowSubscriber) { got = append(got, s) },
+	})
+	sig.SubscribeForever(func(int) {}) // Fast, not reported
+	sig.SubscribeForever(slowSubscriber)
+	sig.Set(1)
+
+	if len(got) != 1 {
+		t.Fatalf("OnSlow called %d times, want 1", len(got))
+	}
+	s := got[0]
+	if s.Duration <= s.Threshold || s.Threshold != 5*time.Millisecond {
+		t.Errorf("Duration %v, Threshold %v, want a duration above 5ms", s.Duration, s.Threshold)
+	}
+	if !strings.HasSuffix(s.Func, ".slowSubscriber") {
+		t.Errorf("Func = %q, want the slowSubscriber callback", s.Func)
+	}
+	if !strings.Contains(string(s.Stack), "TestSlowSubscriber_OnSlow") {
+		t.Errorf("Stack does not lead back to the write:\n%s", s.Stack)
+	}
+
+	fork := sig.Fork()
+	fork.SubscribeForever(slowSubscriber)
+	fork.Set(2)
+	if len(got) != 2 {
+		t.Errorf("fork reported %d slow subscribers, want 1", len(got)-1)
+	}
+}
+
+// TestSlowSubscriber_Computed verifies computed subscribers are timed too, including panicking ones
+func TestSlowSubscriber_Computed(t *testing.T) {
+	n := New(1)
+	var got []SlowSubscriber
+	doubled := ComputedWithOptions(func() int { return n.Get() * 2 }, Options[int]{
+		SlowSubscriberThreshold: 5 * time.Millisecond,
+		OnSlow:                  func(s SlowSubscriber) { got = append(got, s) },
+		OnPanic:                 func(any, []byte) {},
+	}, n)
+	defer doubled.Cleanup()
+	doubled.Get()
+
+	doubled.SubscribeForever(func(v int) {
+		slowSubscriber(v)
+		panic("boom")
+	})
+	n.Set(2)
+	doubled.Get()
+
+	if len(got) != 1 || got[0].Duration <= 5*time.Millisecond {
+		t.Errorf("OnSlow got %+v, want one report above 5ms", got)
+	}
+}
+
+// TestSlowSubscriber_Logged verifies slow callbacks are logged at warn level without OnSlow
+func TestSlowSubscriber_Logged(t *testing.T) {
+	h := &captureHandler{}
+	sig := NewWithOptions(0, Options[int]{
+		SlowSubscriberThreshold: 5 * time.Millisecond,
+		Logger:                  slog.New(h),
+	})
+	sig.SubscribeForever(slowSubscriber)
+	sig.Set(1)
+
+	if r := h.only(t); r.Level != slog.LevelWarn || !strings.Contains(r.Message, "slow subscriber") {
+		t.Errorf("logged %v %q, want a slow subscriber warning", r.Level, r.Message)
+	}
+}
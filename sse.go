@@ -0,0 +1,94 @@
+package signals
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+)
+
+// ErrStreamingUnsupported is returned by ServeSSE when the ResponseWriter
+// can't flush, so events would never reach the client.
+var ErrStreamingUnsupported = errors.New("signals: response writer does not support flushing")
+
+// ServeSSE streams the values of s to an HTTP client as Server-Sent Events,
+// until the client disconnects (r's context is done). The current value is
+// sent first, then each change, as one "data:" event encoded with encode
+// (a multi-line payload becomes one data line per line).
+//
+// A slow client doesn't hold up the signal: changes it hasn't received yet
+// are coalesced to the latest one. ServeSSE sets the event stream headers,
+// subscribes for the duration of the request, and returns nil once the
+// client is gone, or the error that ended the stream.
+//
+// Example:
+//
+//	http.HandleFunc("/metrics/stream", func(w http.ResponseWriter, r *http.Request) {
+//	    _ = signals.ServeSSE(w, r, stats.AsReadonly(), func(s Stats) []byte {
+//	        b, _ := json.Marshal(s)
+//	        return b
+//	    })
+//	})
+func ServeSSE[T any](w http.ResponseWriter, r *http.Request, s ReadonlySignal[T], encode func(T) []byte) error {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return ErrStreamingUnsupported
+	}
+
+	h := w.Header()
+	h.Set("Content-Type", "text/event-stream")
+	h.Set("Cache-Control", "no-cache")
+	h.Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+
+	ctx := r.Context()
+	updates := make(chan T, 1)
+	unsub := s.Subscribe(ctx, func(v T) { offerLatest(updates, v) })
+	defer unsub()
+
+	v := s.Get()
+	for {
+		if err := writeEvent(w, encode(v)); err != nil {
+			return err
+		}
+		flusher.Flush()
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case v = <-updates:
+		}
+	}
+}
+
+// offerLatest puts v in the one-slot channel ch, replacing a value not
+// received yet. Safe for concurrent senders; the last one wins.
+func offerLatest[T any](ch chan T, v T) {
+	for {
+		select {
+		case ch <- v:
+			return
+		default:
+		}
+		select {
+		case <-ch: // Drop the stale value
+		default:
+		}
+	}
+}
+
+// writeEvent writes data as one SSE event, a "data:" line per line of data.
+func writeEvent(w http.ResponseWriter, data []byte) error {
+	var buf bytes.Buffer
+	for line := range bytes.Lines(data) {
+		buf.WriteString("data: ")
+		buf.Write(bytes.TrimRight(line, "\r\n"))
+		buf.WriteByte('\n')
+	}
+	if len(data) == 0 {
+		buf.WriteString("data: \n")
+	}
+	buf.WriteByte('\n')
+	_, err := w.Write(buf.Bytes())
+	return err
+}
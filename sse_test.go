@@ -0,0 +1,81 @@
+package signals
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// readEvent reads the next SSE event's data lines.
+func readEvent(t *testing.T, r *bufio.Reader) string {
+	t.Helper()
+	var data []string
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			t.Fatalf("reading event: %v", err)
+		}
+		line = strings.TrimSuffix(line, "\n")
+		if line == "" {
+			return strings.Join(data, "\n")
+		}
+		data = append(data, strings.TrimPrefix(line, "data: "))
+	}
+}
+
+// TestServeSSE_StreamsValues verifies the current value and each change are sent as events, with SSE headers
+func TestServeSSE_StreamsValues(t *testing.T) {
+	sig := New(1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = ServeSSE(w, r, sig.AsReadonly(), func(v int) []byte { return []byte(strconv.Itoa(v)) })
+	}))
+	defer srv.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL, nil)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if ct := resp.Header.Get("Content-Type"); ct != "text/event-stream" {
+		t.Errorf("Content-Type = %q, want text/event-stream", ct)
+	}
+	body := bufio.NewReader(resp.Body)
+	if got := readEvent(t, body); got != "1" {
+		t.Errorf("first event = %q, want the current value 1", got)
+	}
+	sig.Set(2)
+	if got := readEvent(t, body); got != "2" {
+		t.Errorf("second event = %q, want 2", got)
+	}
+
+	cancel()
+	waitFor(t, func() bool { return subscriberCount(sig) == 0 })
+}
+
+// TestServeSSE_MultilineAndUnsupported verifies multi-line payloads and writers that can't flush
+func TestServeSSE_MultilineAndUnsupported(t *testing.T) {
+	rec := httptest.NewRecorder()
+	if err := writeEvent(rec, []byte("a\nb")); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := rec.Body.String(), "data: a\ndata: b\n\n"; got != want {
+		t.Errorf("event = %q, want %q", got, want)
+	}
+
+	var w struct{ http.ResponseWriter } // Hides the recorder's Flush
+	w.ResponseWriter = httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	err := ServeSSE(w, req, New(0).AsReadonly(), func(int) []byte { return nil })
+	if !errors.Is(err, ErrStreamingUnsupported) {
+		t.Errorf("ServeSSE() = %v, want ErrStreamingUnsupported", err)
+	}
+}
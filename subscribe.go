@@ -0,0 +1,228 @@
+package signals
+
+import (
+	"context"
+	"reflect"
+	"runtime/debug"
+	"sync"
+	"sync/atomic"
+)
+
+// SubscribeWhere registers a callback that only receives values for which
+// pred returns true. Other subscribers of s are unaffected.
+//
+// This is lighter than deriving a filtered signal when only one subscriber
+// needs the filter. pred runs in the notification path like the callback
+// itself, so a panic in either is recovered by s. As with Subscribe, the
+// subscription ends when ctx is canceled or Unsubscribe is called.
+//
+// Example:
+//
+//	unsub := signals.SubscribeWhere(ctx, balance.AsReadonly(),
+//	    func(v int) bool { return v < 0 },
+//	    func(v int) { alert("overdrawn", v) },
+//	)
+//	defer unsub()
+func SubscribeWhere[T any](ctx context.Context, s ReadonlySignal[T], pred func(T) bool, fn func(T)) Unsubscribe {
+	return s.Subscribe(ctx, func(v T) {
+		if pred(v) {
+			fn(v)
+		}
+	})
+}
+
+// SubscribeWithCancelHook is like s.Subscribe, but when the subscription
+// ends because ctx is done, onCancel is called with context.Cause(ctx),
+// after fn has been unsubscribed. That tells a deadline
+// (context.DeadlineExceeded) from a plain or caused cancellation. Calling
+// the returned Unsubscribe first ends the subscription without calling
+// onCancel.
+//
+// Example:
+//
+//	ctx, cancel := context.WithCancelCause(ctx)
+//	signals.SubscribeWithCancelHook(ctx, prices.AsReadonly(), render, func(cause error) {
+//	    log.Printf("price feed detached: %v", cause)
+//	})
+//	// ...
+//	cancel(errShuttingDown)
+func SubscribeWithCancelHook[T any](ctx context.Context, s ReadonlySignal[T], fn func(T), onCancel func(cause error)) Unsubscribe {
+	unsub := s.Subscribe(ctx, fn)
+	stop := context.AfterFunc(ctx, func() {
+		unsub()
+		onCancel(context.Cause(ctx))
+	})
+	return func() {
+		stop()
+		unsub()
+	}
+}
+
+// BindTo mirrors source into target: every change is Set on target until
+// the returned Unsubscribe is called. If initial is true, target is also
+// set to the current value right away.
+//
+// Values that source's Equal reports unchanged on target are skipped, so
+// two signals bound to each other settle as long as either side has an
+// Equal. A read-only view does not expose its signal's Equal; bind the
+// signal itself when that matters.
+//
+// Example:
+//
+//	unbind := signals.BindTo(settings.AsReadonly(), cachedSettings, true)
+//	defer unbind()
+func BindTo[T any](source ReadonlySignal[T], target Signal[T], initial bool) Unsubscribe {
+	mirror := func(v T) {
+		if eq, ok := source.(interface{ equalFunc() EqualFunc[T] }); ok {
+			if equal := eq.equalFunc(); equal != nil && equal(target.Get(), v) {
+				return
+			}
+		}
+		target.Set(v)
+	}
+	if !initial {
+		return source.SubscribeForever(mirror)
+	}
+	if obs, ok := source.(interface{ Observe(fn func(T)) Unsubscribe }); ok {
+		return obs.Observe(mirror)
+	}
+	unbind := source.SubscribeForever(mirror)
+	mirror(source.Get())
+	return unbind
+}
+
+// SubscribeHandle is a subscription made with SubscribeWithHandle. It can
+// replay the current value to its own callback, leaving other subscribers
+// alone.
+type SubscribeHandle[T any] struct {
+	ctx    context.Context
+	source ReadonlySignal[T]
+	fn     func(T)
+	unsub  Unsubscribe
+
+	// stopped is set by Unsubscribe
+	stopped atomic.Bool
+}
+
+// SubscribeWithHandle is like s.Subscribe, but returns a handle whose
+// Refresh calls fn again with the current value, e.g., after fn's
+// configuration changed.
+//
+// Example:
+//
+//	h := signals.SubscribeWithHandle(ctx, theme.AsReadonly(), renderer.Apply)
+//	defer h.Unsubscribe()
+//
+//	renderer.SetScale(2)
+//	h.Refresh() // Re-render with the current theme, nobody else notified
+func SubscribeWithHandle[T any](ctx context.Context, s ReadonlySignal[T], fn func(T)) *SubscribeHandle[T] {
+	return &SubscribeHandle[T]{ctx: ctx, source: s, fn: fn, unsub: s.Subscribe(ctx, fn)}
+}
+
+// Refresh calls the subscriber with the current value of the signal. Other
+// subscribers are not notified. It returns a *PanicError if the callback
+// panicked, and does nothing once the subscription ended.
+//
+// The call is made on the calling goroutine, outside the signal's delivery
+// order: with concurrent writers, it can run at the same time as a
+// notification of the subscriber, and hand it a value older than one it
+// already received. Refresh where the signal isn't being written, or make
+// the callback safe for that.
+func (h *SubscribeHandle[T]) Refresh() (err error) {
+	if h.stopped.Load() || h.ctx.Err() != nil {
+		return nil
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = &PanicError{Value: r, Stack: debug.Stack()}
+		}
+	}()
+	h.fn(h.source.Get())
+	return nil
+}
+
+// Unsubscribe ends the subscription. Safe to call multiple times.
+func (h *SubscribeHandle[T]) Unsubscribe() {
+	h.stopped.Store(true)
+	h.unsub()
+}
+
+// SubscribeFields registers a callback that receives the fields changed by
+// each update of a struct signal, keyed by field name and holding the new
+// field values, along with the new value. Updates that change no field are
+// not delivered.
+//
+// T must be a struct or a pointer to a struct; SubscribeFields panics
+// otherwise. A nil pointer compares like the zero struct. Only exported
+// fields are reported. The diff is one level deep: a nested struct (or
+// slice, map, ...) field is reported as a whole when anything in it
+// changed, compared with reflect.DeepEqual. Func fields are always
+// reported, since funcs are only DeepEqual when nil.
+//
+// The first diff is against the value held when SubscribeFields is called.
+// As with Subscribe, the subscription ends when ctx is canceled or
+// Unsubscribe is called.
+//
+// Example:
+//
+//	unsub := signals.SubscribeFields(ctx, user.AsReadonly(),
+//	    func(changed map[string]any, _ User) {
+//	        frontend.Patch(changed) // e.g. {"Name": "Alicia"}
+//	    },
+//	)
+//	defer unsub()
+func SubscribeFields[T any](ctx context.Context, s ReadonlySignal[T], fn func(changed map[string]any, value T)) Unsubscribe {
+	typ := reflect.TypeFor[T]()
+	if typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+	if typ.Kind() != reflect.Struct {
+		panic("signals: SubscribeFields on non-struct type " + reflect.TypeFor[T]().String())
+	}
+
+	var mu sync.Mutex
+	prev := s.Get()
+	return s.Subscribe(ctx, func(value T) {
+		mu.Lock()
+		changed := changedFields(typ, reflect.ValueOf(&prev).Elem(), reflect.ValueOf(&value).Elem())
+		prev = value
+		mu.Unlock()
+
+		if len(changed) > 0 {
+			fn(changed, value)
+		}
+	})
+}
+
+// changedFields returns the exported fields of struct type typ that differ
+// between old and new (structs or pointers to them), with their new values.
+func changedFields(typ reflect.Type, old, new reflect.Value) map[string]any {
+	old, new = structValue(typ, old), structValue(typ, new)
+
+	var changed map[string]any
+	for i := range typ.NumField() {
+		if !typ.Field(i).IsExported() {
+			continue
+		}
+		o, n := old.Field(i).Interface(), new.Field(i).Interface()
+		if reflect.DeepEqual(o, n) {
+			continue
+		}
+		if changed == nil {
+			changed = make(map[string]any)
+		}
+		changed[typ.Field(i).Name] = n
+	}
+	return changed
+}
+
+// structValue dereferences a struct pointer, using the zero value for nil.
+func structValue(typ reflect.Type, v reflect.Value) reflect.Value {
+	if v.Kind() != reflect.Pointer {
+		return v
+	}
+	if v.IsNil() {
+		return reflect.Zero(typ)
+	}
+	return v.Elem()
+}
@@ -0,0 +1,360 @@
+package signals
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// TestSubscribeWhere_FiltersValues verifies fn only sees values matching pred
+func TestSubscribeWhere_FiltersValues(t *testing.T) {
+	sig := New(0)
+	var positive, all []int
+
+	unsub := SubscribeWhere(context.Background(), sig.AsReadonly(),
+		func(v int) bool { return v > 0 },
+		func(v int) { positive = append(positive, v) },
+	)
+	defer unsub()
+	sig.SubscribeForever(func(v int) { all = append(all, v) })
+
+	for _, v := range []int{3, -1, 0, 5, -7} {
+		sig.Set(v)
+	}
+
+	if !slices.Equal(positive, []int{3, 5}) {
+		t.Errorf("filtered subscriber received %v, want [3 5]", positive)
+	}
+	if !slices.Equal(all, []int{3, -1, 0, 5, -7}) {
+		t.Errorf("other subscriber received %v, want every value", all)
+	}
+}
+
+// TestSubscribeWhere_PredicatePanic verifies a panicking predicate is recovered
+func TestSubscribeWhere_PredicatePanic(t *testing.T) {
+	var recovered any
+	sig := NewWithOptions(0, Options[int]{
+		OnPanic: func(err any, _ []byte) { recovered = err },
+	})
+	var got []int
+
+	SubscribeWhere(context.Background(), sig.AsReadonly(),
+		func(v int) bool {
+			if v == 2 {
+				panic("bad predicate")
+			}
+			return true
+		},
+		func(v int) { got = append(got, v) },
+	)
+
+	sig.Set(1)
+	sig.Set(2)
+	sig.Set(3)
+
+	if recovered != "bad predicate" {
+		t.Errorf("OnPanic received %v, want the predicate panic", recovered)
+	}
+	if !slices.Equal(got, []int{1, 3}) {
+		t.Errorf("received %v, want [1 3]", got)
+	}
+}
+
+// TestSubscribeWhere_Unsubscribe verifies the filtered subscription can be removed
+func TestSubscribeWhere_Unsubscribe(t *testing.T) {
+	sig := New(0)
+	calls := 0
+	unsub := SubscribeWhere(context.Background(), sig.AsReadonly(),
+		func(int) bool { return true },
+		func(int) { calls++ },
+	)
+
+	sig.Set(1)
+	unsub()
+	sig.Set(2)
+
+	if calls != 1 {
+		t.Errorf("calls = %d, want 1", calls)
+	}
+	if got := subscriberCount(sig); got != 0 {
+		t.Errorf("subscriber count = %d, want 0", got)
+	}
+}
+
+// profile is a struct signal value for the SubscribeFields tests
+type profile struct {
+	Name    string
+	Age     int
+	Tags    []string
+	Address struct{ City string }
+	secret  string
+}
+
+// TestSubscribeWithCancelHook_Causes verifies onCancel receives the cause of the context ending
+func TestSubscribeWithCancelHook_Causes(t *testing.T) {
+	errShutdown := errors.New("shutting down")
+	timeoutCtx, cancelTimeout := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancelTimeout()
+	causeCtx, cancelCause := context.WithCancelCause(context.Background())
+
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		cancel func()
+		want   error
+	}{
+		{"deadline", timeoutCtx, func() {}, context.DeadlineExceeded},
+		{"cause", causeCtx, func() { cancelCause(errShutdown) }, errShutdown},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sig := New(0)
+			causes := make(chan error, 1)
+			SubscribeWithCancelHook(tt.ctx, sig.AsReadonly(), func(int) {}, func(cause error) { causes <- cause })
+
+			tt.cancel()
+			select {
+			case cause := <-causes:
+				if !errors.Is(cause, tt.want) {
+					t.Errorf("onCancel(%v), want %v", cause, tt.want)
+				}
+			case <-time.After(time.Second):
+				t.Fatal("onCancel not called")
+			}
+			if n := subscriberCount(sig); n != 0 {
+				t.Errorf("%d subscribers left when onCancel ran, want 0", n)
+			}
+		})
+	}
+}
+
+// TestSubscribeWithCancelHook_ManualUnsubscribe verifies Unsubscribe doesn't call onCancel
+func TestSubscribeWithCancelHook_ManualUnsubscribe(t *testing.T) {
+	sig := New(0)
+	ctx, cancel := context.WithCancel(context.Background())
+	var called atomic.Bool
+	unsub := SubscribeWithCancelHook(ctx, sig.AsReadonly(), func(int) {}, func(error) { called.Store(true) })
+
+	unsub()
+	cancel()
+	time.Sleep(10 * time.Millisecond)
+	if called.Load() {
+		t.Error("onCancel called after Unsubscribe")
+	}
+	if n := subscriberCount(sig); n != 0 {
+		t.Errorf("%d subscribers left, want 0", n)
+	}
+}
+
+// TestBindTo_Unbind verifies the target tracks the source until unbound
+func TestBindTo_Unbind(t *testing.T) {
+	source, target := New(1), New(0)
+
+	unbind := BindTo(source, target, false)
+	if got := target.Get(); got != 0 {
+		t.Errorf("target = %d before any change, want 0", got)
+	}
+
+	source.Set(2)
+	if got := target.Get(); got != 2 {
+		t.Errorf("target = %d after Set, want 2", got)
+	}
+
+	unbind()
+	source.Set(3)
+	if got := target.Get(); got != 2 {
+		t.Errorf("target = %d after unbind, want 2", got)
+	}
+}
+
+// TestBindTo_Initial verifies an initial sync copies the current value
+func TestBindTo_Initial(t *testing.T) {
+	source, target := New("a"), New("")
+
+	unbind := BindTo(source, target, true)
+	defer unbind()
+
+	if got := target.Get(); got != "a" {
+		t.Errorf("target = %q, want %q", got, "a")
+	}
+	source.Set("b")
+	if got := target.Get(); got != "b" {
+		t.Errorf("target = %q, want %q", got, "b")
+	}
+}
+
+// TestBindTo_BothWays verifies mutual bindings settle when Equal is set
+func TestBindTo_BothWays(t *testing.T) {
+	a := NewComparable(0)
+	b := New(0) // Equal on one side is enough
+
+	var writes atomic.Int32
+	b.SubscribeForever(func(int) { writes.Add(1) })
+
+	unbindA := BindTo(a, b, false)
+	defer unbindA()
+	unbindB := BindTo(b, a, false)
+	defer unbindB()
+
+	done := make(chan struct{})
+	go func() {
+		a.Set(1)
+		b.Set(2)
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("mutual binding did not settle")
+	}
+
+	if a.Get() != 2 || b.Get() != 2 {
+		t.Errorf("a = %d, b = %d, want both 2", a.Get(), b.Get())
+	}
+	if got := writes.Load(); got != 2 {
+		t.Errorf("b notified %d times, want 2", got)
+	}
+}
+
+// TestBindTo_ReadonlySource verifies a read-only source is synced initially and then mirrored
+func TestBindTo_ReadonlySource(t *testing.T) {
+	source, target := New(1), New(0)
+
+	unbind := BindTo(source.AsReadonly(), target, true)
+	defer unbind()
+
+	if got := target.Get(); got != 1 {
+		t.Errorf("target = %d after initial sync, want 1", got)
+	}
+	source.Set(2)
+	if got := target.Get(); got != 2 {
+		t.Errorf("target = %d after Set, want 2", got)
+	}
+}
+
+// TestSubscribeHandle_Refresh verifies Refresh replays the current value to that subscriber only
+func TestSubscribeHandle_Refresh(t *testing.T) {
+	sig := New(1)
+	var mine, other []int
+	h := SubscribeWithHandle(context.Background(), sig.AsReadonly(), func(v int) { mine = append(mine, v) })
+	sig.SubscribeForever(func(v int) { other = append(other, v) })
+
+	sig.Set(2)
+	if err := h.Refresh(); err != nil {
+		t.Fatalf("Refresh() = %v, want nil", err)
+	}
+
+	if want := []int{2, 2}; !slices.Equal(mine, want) {
+		t.Errorf("subscriber got %v, want %v", mine, want)
+	}
+	if want := []int{2}; !slices.Equal(other, want) {
+		t.Errorf("other subscriber got %v, want %v (untouched by Refresh)", other, want)
+	}
+}
+
+// TestSubscribeHandle_RefreshPanic verifies a panicking callback is recovered into a PanicError
+func TestSubscribeHandle_RefreshPanic(t *testing.T) {
+	sig := New(0)
+	h := SubscribeWithHandle(context.Background(), sig.AsReadonly(), func(int) { panic("boom") })
+	defer h.Unsubscribe()
+
+	var pe *PanicError
+	if err := h.Refresh(); !errors.As(err, &pe) || pe.Value != "boom" {
+		t.Errorf("Refresh() = %v, want a PanicError with boom", err)
+	}
+}
+
+// TestSubscribeHandle_RefreshAfterUnsubscribe verifies Refresh does nothing once the subscription ended
+func TestSubscribeHandle_RefreshAfterUnsubscribe(t *testing.T) {
+	sig := New(0)
+	var calls int
+	h := SubscribeWithHandle(context.Background(), sig.AsReadonly(), func(int) { calls++ })
+	h.Unsubscribe()
+	h.Refresh()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	h = SubscribeWithHandle(ctx, sig.AsReadonly(), func(int) { calls++ })
+	cancel()
+	h.Refresh()
+
+	if calls != 0 {
+		t.Errorf("callback called %d times, want 0", calls)
+	}
+}
+
+// TestSubscribeFields_ReportsChangedField verifies only the mutated field is reported
+func TestSubscribeFields_ReportsChangedField(t *testing.T) {
+	sig := New(profile{Name: "Alice", Age: 30, Tags: []string{"a"}})
+
+	var got []map[string]any
+	unsub := SubscribeFields(context.Background(), sig.AsReadonly(), func(changed map[string]any, _ profile) {
+		got = append(got, changed)
+	})
+	defer unsub()
+
+	sig.Update(func(p profile) profile {
+		p.Age = 31
+		return p
+	})
+
+	if len(got) != 1 {
+		t.Fatalf("got %d diffs, want 1", len(got))
+	}
+	if len(got[0]) != 1 || got[0]["Age"] != 31 {
+		t.Errorf("changed = %v, want map[Age:31]", got[0])
+	}
+}
+
+// TestSubscribeFields_ShallowAndExportedOnly verifies nested fields are reported whole and unexported ones skipped
+func TestSubscribeFields_ShallowAndExportedOnly(t *testing.T) {
+	sig := New(profile{Tags: []string{"a"}})
+
+	var got []map[string]any
+	unsub := SubscribeFields(context.Background(), sig.AsReadonly(), func(changed map[string]any, _ profile) {
+		got = append(got, changed)
+	})
+	defer unsub()
+
+	next := profile{Tags: []string{"a"}, secret: "hidden"} // Equal slice, unexported change
+	sig.Set(next)
+	next.Address.City = "Paris"
+	sig.Set(next)
+
+	if len(got) != 1 {
+		t.Fatalf("got diffs %v, want a single one", got)
+	}
+	if city, ok := got[0]["Address"].(struct{ City string }); len(got[0]) != 1 || !ok || city.City != "Paris" {
+		t.Errorf("changed = %v, want the whole Address", got[0])
+	}
+}
+
+// TestSubscribeFields_Pointers verifies struct pointers are diffed, nil as the zero struct
+func TestSubscribeFields_Pointers(t *testing.T) {
+	sig := New[*profile](nil)
+
+	var got []map[string]any
+	unsub := SubscribeFields(context.Background(), sig.AsReadonly(), func(changed map[string]any, _ *profile) {
+		got = append(got, changed)
+	})
+	defer unsub()
+
+	sig.Set(&profile{Name: "Bob"})
+	sig.Set(&profile{Name: "Bob"})
+
+	if len(got) != 1 || len(got[0]) != 1 || got[0]["Name"] != "Bob" {
+		t.Errorf("diffs = %v, want [map[Name:Bob]]", got)
+	}
+}
+
+// TestSubscribeFields_NonStructPanics verifies a non-struct type is rejected up front
+func TestSubscribeFields_NonStructPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("SubscribeFields on an int signal did not panic")
+		}
+	}()
+	SubscribeFields(context.Background(), New(1).AsReadonly(), func(map[string]any, int) {})
+}
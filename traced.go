@@ -0,0 +1,66 @@
+package signals
+
+import "context"
+
+// Events reported to the hook of Traced.
+const (
+	// TraceGet is a call to Get.
+	TraceGet = "get"
+
+	// TraceNotify is the delivery of a value to a subscriber.
+	TraceNotify = "notify"
+)
+
+// tracedSignal is the read-only decorator returned by Traced.
+type tracedSignal[T any] struct {
+	dependencyView[T]
+	hook func(event string)
+}
+
+// Traced wraps s to call hook on every access through the wrapper:
+// TraceGet on each Get, and TraceNotify each time a subscriber registered
+// through it is notified, just before the callback runs. Everything else
+// is delegated to s, which is left untouched; accesses not made through
+// the wrapper are not reported.
+//
+// Computeds and effects may depend on the traced signal like on s; their
+// reads of it are reported as TraceGet. hook runs on the accessing
+// goroutine, so keep it fast.
+//
+// Example:
+//
+//	var gets, notifies atomic.Int64
+//	cfg := signals.Traced(config.AsReadonly(), func(event string) {
+//	    if event == signals.TraceGet {
+//	        gets.Add(1)
+//	    } else {
+//	        notifies.Add(1)
+//	    }
+//	})
+func Traced[T any](s ReadonlySignal[T], hook func(event string)) ReadonlySignal[T] {
+	return &tracedSignal[T]{dependencyView: dependencyView[T]{source: s}, hook: hook}
+}
+
+// Get reports TraceGet and returns the source's value.
+func (t *tracedSignal[T]) Get() T {
+	t.hook(TraceGet)
+	return t.source.Get()
+}
+
+// Subscribe registers fn with the source, reporting TraceNotify before each call.
+func (t *tracedSignal[T]) Subscribe(ctx context.Context, fn func(T)) Unsubscribe {
+	return t.source.Subscribe(ctx, t.traced(fn))
+}
+
+// SubscribeForever is like Subscribe, never auto-canceling.
+func (t *tracedSignal[T]) SubscribeForever(fn func(T)) Unsubscribe {
+	return t.source.SubscribeForever(t.traced(fn))
+}
+
+// traced wraps fn to report TraceNotify.
+func (t *tracedSignal[T]) traced(fn func(T)) func(T) {
+	return func(v T) {
+		t.hook(TraceNotify)
+		fn(v)
+	}
+}
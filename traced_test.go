@@ -0,0 +1,56 @@
+package signals
+
+import (
+	"slices"
+	"testing"
+)
+
+// TestTraced_Events verifies the hook sees each Get and each delivered notification
+func TestTraced_Events(t *testing.T) {
+	sig := New(1)
+	var events []string
+	traced := Traced(sig.AsReadonly(), func(event string) { events = append(events, event) })
+
+	var got []int
+	traced.SubscribeForever(func(v int) { got = append(got, v) })
+	traced.SubscribeForever(func(int) {})
+
+	if v := traced.Get(); v != 1 {
+		t.Errorf("Get() = %d, want 1", v)
+	}
+	sig.Set(2)
+	sig.Get() // Not through the wrapper
+
+	want := []string{TraceGet, TraceNotify, TraceNotify}
+	if !slices.Equal(events, want) {
+		t.Errorf("events = %v, want %v", events, want)
+	}
+	if !slices.Equal(got, []int{2}) {
+		t.Errorf("subscriber got %v, want [2]", got)
+	}
+}
+
+// TestTraced_AsDependency verifies computeds over a traced signal update and their reads are traced
+func TestTraced_AsDependency(t *testing.T) {
+	sig := New(1)
+	var gets int
+	traced := Traced(sig.AsReadonly(), func(event string) {
+		if event == TraceGet {
+			gets++
+		}
+	})
+	doubled := Computed(func() int { return traced.Get() * 2 }, traced)
+	defer doubled.Cleanup()
+
+	doubled.Get()
+	sig.Set(5)
+	if v := doubled.Get(); v != 10 {
+		t.Errorf("doubled = %d, want 10", v)
+	}
+	if gets != 2 {
+		t.Errorf("traced %d gets, want 2 (one per recompute)", gets)
+	}
+	if d := sig.Dependents(); d.Computed != 1 {
+		t.Errorf("source Dependents() = %+v, want the computed tracked", d)
+	}
+}
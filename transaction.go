@@ -0,0 +1,239 @@
+package signals
+
+import (
+	"errors"
+	"runtime/debug"
+	"slices"
+)
+
+// Tx collects the writes of a Transaction. Add writes with TxSet.
+type Tx struct {
+	writes []txWrite
+}
+
+// txWrite is a write staged in a Tx.
+type txWrite interface {
+	// key identifies the written signal, see dependencyKey
+	key() any
+
+	// check reports whether the write would be rejected by Validate
+	// or because the signal is closed
+	check() error
+
+	// direct reports whether commit stores the value without notifying,
+	// for the signals created by New and its variants
+	direct() bool
+
+	// commit stores the value and returns what is left to notify: the
+	// reactions to propagate, and the subscriber delivery if any
+	commit() (reactions []reaction, deliver func(), err error)
+
+	// rollback restores the value a successful commit replaced, dropping
+	// its notification if it has not been delivered
+	rollback()
+
+	// handlePanic reports a panic of one of the signal's reactions
+	handlePanic(r any, sink *[]error)
+}
+
+// Transaction runs fn and then applies the writes it staged with TxSet, all
+// or nothing:
+//   - if fn returns an error, nothing is written and that error is returned
+//   - if a staged value is rejected by its signal's Validate (or the signal
+//     is closed), nothing is written and the error is returned
+//   - otherwise every write is committed, and only then are subscribers,
+//     computeds, and effects notified: each computed and effect once for
+//     the whole transaction, after all the values have changed
+//
+// Writes are staged: within fn, Get still returns the values from before
+// the transaction. A write can still fail as it is committed: a signal
+// closed by another goroutine meanwhile (ErrSignalClosed), a signal with
+// Interceptors, whose intercepted value is only validated then, or any
+// write to another Signal implementation. The writes committed before it
+// are then rolled back, and the error is returned. A signal written by
+// someone else in between keeps that value.
+//
+// The signals created by New and its variants are committed first, and
+// roll back without notifying. Other Signal implementations, such as those
+// of NewAutoReset, NewReplay or MapTwoWay, are written with SetE, and
+// notify, as the transaction commits; rolling one back writes its previous
+// value again with SetE.
+//
+// Example:
+//
+//	err := signals.Transaction(func(tx *signals.Tx) error {
+//	    signals.TxSet(tx, from, from.Get()-amount)
+//	    signals.TxSet(tx, to, to.Get()+amount)
+//	    if from.Get() < amount {
+//	        return ErrInsufficientFunds // Neither balance changes
+//	    }
+//	    return nil
+//	})
+func Transaction(fn func(tx *Tx) error) error {
+	tx := &Tx{}
+	if err := fn(tx); err != nil {
+		return err
+	}
+	for _, w := range tx.writes {
+		if err := w.check(); err != nil {
+			return err
+		}
+	}
+	return tx.commit()
+}
+
+// TxSet stages a write of value to sig in tx; see Transaction. Staging
+// the same signal again replaces the value.
+//
+// TxSet is a function rather than a method of Tx because Go methods can't
+// have type parameters.
+func TxSet[T any](tx *Tx, sig Signal[T], value T) {
+	key := dependencyKey(sig)
+	for _, w := range tx.writes {
+		if sameDependency(w.key(), key) {
+			if staged, ok := w.(*stagedWrite[T]); ok {
+				staged.value = value
+				return
+			}
+		}
+	}
+	tx.writes = append(tx.writes, &stagedWrite[T]{target: sig, value: value})
+}
+
+// commit stores every staged write, then notifies them together. If a
+// write fails, the ones before it are rolled back.
+func (tx *Tx) commit() error {
+	var (
+		reactions  []reaction
+		deliveries []func()
+	)
+	writes := tx.ordered()
+	for i, w := range writes {
+		r, deliver, err := w.commit()
+		if err != nil {
+			for _, committed := range slices.Backward(writes[:i]) {
+				committed.rollback()
+			}
+			return err
+		}
+		reactions = append(reactions, r...)
+		if deliver != nil {
+			deliveries = append(deliveries, deliver)
+		}
+	}
+
+	// One wave for all the writes; only internal callbacks can panic here,
+	// and they are reported like those of the first signal written
+	if len(reactions) > 0 {
+		propagate(reactions, writes[0].handlePanic, nil)
+	}
+	for _, deliver := range deliveries {
+		deliver()
+	}
+	return nil
+}
+
+// ordered returns the staged writes, those that commit without notifying
+// first, so that a failing write usually has nothing visible to roll back.
+func (tx *Tx) ordered() []txWrite {
+	writes := make([]txWrite, 0, len(tx.writes))
+	for _, w := range tx.writes {
+		if w.direct() {
+			writes = append(writes, w)
+		}
+	}
+	for _, w := range tx.writes {
+		if !w.direct() {
+			writes = append(writes, w)
+		}
+	}
+	return writes
+}
+
+// stagedWrite is a txWrite to a Signal[T].
+type stagedWrite[T any] struct {
+	target Signal[T]
+	value  T
+
+	// prior is the value commit replaced, restored by rollback
+	prior T
+
+	// written is what commit stored in a *signal[T] target
+	written committedWrite[T]
+
+	// setE is set once commit wrote another target with SetE
+	setE bool
+}
+
+func (w *stagedWrite[T]) key() any {
+	return dependencyKey(w.target)
+}
+
+func (w *stagedWrite[T]) check() error {
+	s, ok := w.target.(*signal[T])
+	if !ok {
+		return nil // Validated by SetE on commit
+	}
+	if s.closed.Load() {
+		return ErrSignalClosed
+	}
+	if len(s.interceptors) > 0 {
+		return nil // Only the intercepted value is validated, on commit
+	}
+	if err := s.validate(w.value); err != nil {
+		s.reject(w.value, err)
+		return err
+	}
+	return nil
+}
+
+func (w *stagedWrite[T]) direct() bool {
+	_, ok := w.target.(*signal[T])
+	return ok
+}
+
+func (w *stagedWrite[T]) commit() ([]reaction, func(), error) {
+	s, ok := w.target.(*signal[T])
+	if !ok {
+		prior := w.target.Get()
+		if err := w.target.SetE(w.value); err != nil {
+			return nil, nil, err
+		}
+		w.prior, w.setE = prior, true
+		return nil, nil, nil
+	}
+
+	newValue, cw, err := s.commitUpdate(func(old T) (T, bool) {
+		w.prior = old
+		return w.value, true
+	}, nil)
+	if err != nil {
+		if !errors.Is(err, ErrSignalClosed) {
+			s.reject(newValue, err)
+		}
+		return nil, nil, err
+	}
+	w.written = cw
+	if !cw.deliver {
+		return cw.reactions, nil, nil
+	}
+	return cw.reactions, func() { s.deliver(cw.callbacks, cw.current, nil, nil) }, nil
+}
+
+func (w *stagedWrite[T]) rollback() {
+	if s, ok := w.target.(*signal[T]); ok {
+		s.revert(w.prior, w.written)
+		return
+	}
+	if w.setE {
+		_ = w.target.SetE(w.prior)
+	}
+}
+
+func (w *stagedWrite[T]) handlePanic(r any, sink *[]error) {
+	if s, ok := w.target.(*signal[T]); ok {
+		s.handlePanic(r, sink)
+		return
+	}
+	logPanic(nil, "subscriber", r, debug.Stack())
+}
@@ -0,0 +1,242 @@
+package signals
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+)
+
+// TestTransaction_RollsBackOnError verifies a failing transaction leaves every signal unchanged and silent
+func TestTransaction_RollsBackOnError(t *testing.T) {
+	a, b := New(1), New("x")
+
+	var notified int
+	a.SubscribeForever(func(int) { notified++ })
+	b.SubscribeForever(func(string) { notified++ })
+	eff := Effect(func() { a.Get(); b.Get() }, a.AsReadonly(), b.AsReadonly())
+	defer eff.Stop()
+
+	errFailed := errors.New("failed")
+	err := Transaction(func(tx *Tx) error {
+		TxSet(tx, a, 2)
+		TxSet(tx, b, "y")
+		return errFailed
+	})
+
+	if !errors.Is(err, errFailed) {
+		t.Errorf("Transaction() = %v, want %v", err, errFailed)
+	}
+	if a.Get() != 1 || b.Get() != "x" {
+		t.Errorf("a = %d, b = %q, want 1 and %q", a.Get(), b.Get(), "x")
+	}
+	if notified != 0 {
+		t.Errorf("subscribers notified %d times, want 0", notified)
+	}
+}
+
+// TestTransaction_ValidationFailure verifies a rejected value aborts the whole transaction
+func TestTransaction_ValidationFailure(t *testing.T) {
+	errNegative := errors.New("negative")
+	a := New(1)
+	b := NewWithOptions(1, Options[int]{
+		Validate: func(v int) error {
+			if v < 0 {
+				return errNegative
+			}
+			return nil
+		},
+	})
+
+	var notified int
+	a.SubscribeForever(func(int) { notified++ })
+
+	err := Transaction(func(tx *Tx) error {
+		TxSet(tx, a, 5)
+		TxSet(tx, b, -1)
+		return nil
+	})
+
+	if !errors.Is(err, errNegative) {
+		t.Errorf("Transaction() = %v, want %v", err, errNegative)
+	}
+	if a.Get() != 1 || b.Get() != 1 {
+		t.Errorf("a = %d, b = %d, want both unchanged at 1", a.Get(), b.Get())
+	}
+	if notified != 0 {
+		t.Errorf("subscribers notified %d times, want 0", notified)
+	}
+}
+
+// TestTransaction_NotifiesOnceAfterCommit verifies effects run once, seeing every committed value
+func TestTransaction_NotifiesOnceAfterCommit(t *testing.T) {
+	a, b := New(1), New(10)
+	sum := Computed(func() int { return a.Get() + b.Get() }, a.AsReadonly(), b.AsReadonly())
+
+	var seen []string
+	eff := Effect(func() {
+		seen = append(seen, fmt.Sprintf("%d+%d=%d", a.Get(), b.Get(), sum.Get()))
+	}, a.AsReadonly(), b.AsReadonly(), sum)
+	defer eff.Stop()
+
+	var aValues []int
+	a.SubscribeForever(func(v int) { aValues = append(aValues, v) })
+
+	err := Transaction(func(tx *Tx) error {
+		TxSet(tx, a, 2)
+		TxSet(tx, b, 20)
+		TxSet(tx, a, 3) // Replaces the staged 2
+		if a.Get() != 1 {
+			t.Errorf("a.Get() inside transaction = %d, want 1 (writes are staged)", a.Get())
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Transaction() = %v", err)
+	}
+
+	if want := []string{"1+10=11", "3+20=23"}; !slices.Equal(seen, want) {
+		t.Errorf("effect saw %q, want %q", seen, want)
+	}
+	if want := []int{3}; !slices.Equal(aValues, want) {
+		t.Errorf("a notified with %v, want %v", aValues, want)
+	}
+}
+
+// TestTransaction_OtherSignals verifies signals other than New's are written on commit
+func TestTransaction_OtherSignals(t *testing.T) {
+	source := New(1)
+	text := MapTwoWay(source, func(v int) string { return fmt.Sprint(v) }, func(s string) int {
+		var v int
+		_, _ = fmt.Sscan(s, &v)
+		return v
+	})
+
+	err := Transaction(func(tx *Tx) error {
+		TxSet(tx, text, "42")
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Transaction() = %v", err)
+	}
+	if got := source.Get(); got != 42 {
+		t.Errorf("source = %d, want 42", got)
+	}
+}
+
+// TestTransaction_RollsBackOtherSignals verifies a write rejected on commit by a NewAutoReset signal undoes the writes before it
+func TestTransaction_RollsBackOtherSignals(t *testing.T) {
+	errBad := errors.New("bad")
+	opts := Options[string]{
+		Clock: NewFakeClock(time.Now()),
+		Validate: func(v string) error {
+			if v == "bad" {
+				return errBad
+			}
+			return nil
+		},
+	}
+	a := New(1)
+	busy := NewAutoReset("idle", "idle", time.Second, opts)
+	defer busy.Close()
+	status := NewAutoReset("idle", "idle", time.Second, opts)
+	defer status.Close()
+
+	var notified int
+	a.SubscribeForever(func(int) { notified++ })
+
+	err := Transaction(func(tx *Tx) error {
+		TxSet(tx, busy, "busy")
+		TxSet(tx, status, "bad")
+		TxSet(tx, a, 5)
+		return nil
+	})
+
+	if !errors.Is(err, errBad) {
+		t.Errorf("Transaction() = %v, want %v", err, errBad)
+	}
+	if a.Get() != 1 || busy.Get() != "idle" {
+		t.Errorf("a = %d, busy = %q, want 1 and %q", a.Get(), busy.Get(), "idle")
+	}
+	if notified != 0 {
+		t.Errorf("a notified %d times, want 0", notified)
+	}
+}
+
+// TestTransaction_RollsBackInterceptedValue verifies an intercepted value rejected on commit undoes the writes before it
+func TestTransaction_RollsBackInterceptedValue(t *testing.T) {
+	errNegative := errors.New("negative")
+	a := New(1)
+	b := NewWithOptions(1, Options[int]{
+		Interceptors: []func(old, new int) int{func(_, v int) int { return -v }},
+		Validate: func(v int) error {
+			if v < 0 {
+				return errNegative
+			}
+			return nil
+		},
+	})
+
+	var notified int
+	a.SubscribeForever(func(int) { notified++ })
+	doubled := Computed(func() int { return a.Get() * 2 }, a.AsReadonly())
+
+	err := Transaction(func(tx *Tx) error {
+		TxSet(tx, a, 5)
+		TxSet(tx, b, 2)
+		return nil
+	})
+
+	if !errors.Is(err, errNegative) {
+		t.Errorf("Transaction() = %v, want %v", err, errNegative)
+	}
+	if a.Get() != 1 || b.Get() != 1 {
+		t.Errorf("a = %d, b = %d, want both unchanged at 1", a.Get(), b.Get())
+	}
+	if got := doubled.Get(); got != 2 {
+		t.Errorf("doubled = %d, want 2", got)
+	}
+	if notified != 0 {
+		t.Errorf("a notified %d times, want 0", notified)
+	}
+}
+
+// TestTransaction_RollsBackQueuedNotification verifies a rolled back write made from a subscriber is never delivered
+func TestTransaction_RollsBackQueuedNotification(t *testing.T) {
+	errNegative := errors.New("negative")
+	negated := NewWithOptions(0, Options[int]{
+		Interceptors: []func(old, new int) int{func(_, v int) int { return -v }},
+		Validate: func(v int) error {
+			if v < 0 {
+				return errNegative
+			}
+			return nil
+		},
+	})
+	a := New(0)
+
+	var seen []int
+	var err error
+	a.SubscribeForever(func(v int) {
+		seen = append(seen, v)
+		if v == 1 {
+			err = Transaction(func(tx *Tx) error {
+				TxSet(tx, a, 2)
+				TxSet(tx, negated, 1)
+				return nil
+			})
+		}
+	})
+	a.Set(1)
+
+	if !errors.Is(err, errNegative) {
+		t.Errorf("Transaction() = %v, want %v", err, errNegative)
+	}
+	if got := a.Get(); got != 1 {
+		t.Errorf("a = %d, want 1", got)
+	}
+	if want := []int{1}; !slices.Equal(seen, want) {
+		t.Errorf("subscriber saw %v, want %v", seen, want)
+	}
+}
@@ -36,6 +36,19 @@ type Signal[T any] interface {
 	// All subscribers are notified after the value is updated.
 	Set(value T)
 
+	// SetE is like Set but returns the error from Options.Validate if the
+	// value is rejected. Returns nil when the value was accepted (or was
+	// equal to the current value).
+	SetE(value T) error
+
+	// Close removes all subscribers, computeds, and effects and makes later
+	// writes no-ops (SetE returns ErrSignalClosed). Get keeps working.
+	// Subscribing afterwards registers nothing and returns a no-op Unsubscribe.
+	Close()
+
+	// CloseWith delivers a final value to subscribers, then calls Close.
+	CloseWith(final T)
+
 	// Update transforms the signal's value using the provided function.
 	// The function receives the current value and returns the new value.
 	//
@@ -62,6 +75,41 @@ type Signal[T any] interface {
 	//   }
 	AsReadonly() ReadonlySignal[T]
 
+	// Fork returns a new, independent signal initialized with the current value.
+	// The fork carries over this signal's options (Equal, OnPanic) but shares
+	// no subscribers: writes to either signal never notify the other.
+	//
+	// Useful for "what-if" scenarios that branch off live state.
+	//
+	// Example:
+	//   draft := settings.Fork()
+	//   draft.Set(edited)  // settings subscribers are not notified
+	Fork() Signal[T]
+
+	// SetEqual replaces the Equal function given in Options (nil means
+	// always notify), keeping the subscribers. It only affects later
+	// writes and is safe to call concurrently with them.
+	//
+	// Example:
+	//   price.SetEqual(func(a, b float64) bool { return math.Abs(a-b) < 0.01 })
+	SetEqual(fn EqualFunc[T])
+
+	// Dependents reports how many computeds and effects currently depend on
+	// this signal. Useful for finding hotspots ("this signal drives 400 effects").
+	//
+	// Dependents are removed when the computed is cleaned up or the effect stopped.
+	Dependents() Dependents
+
+	// SubscriberCountSignal returns a signal holding the number of
+	// subscribers (not computeds or effects), updated as they subscribe and
+	// leave, whether by Unsubscribe, context cancellation, or Close.
+	//
+	// Example:
+	//   prices.SubscriberCountSignal().SubscribeForever(func(n int) {
+	//       feed.SetActive(n > 0) // Only poll while someone listens
+	//   })
+	SubscriberCountSignal() ReadonlySignal[int]
+
 	// Subscribe registers a callback to be notified when the signal's value changes.
 	// The callback receives the new value.
 	//
@@ -91,6 +139,21 @@ type Signal[T any] interface {
 	//   })
 	//   defer unsub()  // REQUIRED for cleanup
 	SubscribeForever(fn func(T)) Unsubscribe
+
+	// Observe calls fn with the current value right away, then on every
+	// change, like an effect on this signal alone. The returned Unsubscribe
+	// stops it and MUST be called, as with SubscribeForever.
+	//
+	// The initial value is delivered in order with concurrent changes, never
+	// concurrently with them. Called from within a delivery of this signal,
+	// fn gets it once that delivery is done.
+	//
+	// Example:
+	//   stop := theme.Observe(func(t string) {
+	//       applyTheme(t)  // Now, and whenever the theme changes
+	//   })
+	//   defer stop()
+	Observe(fn func(T)) Unsubscribe
 }
 
 // ReadonlySignal is a read-only view of a Signal.
@@ -124,3 +187,228 @@ type ReadonlySignal[T any] interface {
 	// SubscribeForever registers a callback that will never be automatically canceled.
 	SubscribeForever(fn func(T)) Unsubscribe
 }
+
+// ComputedSignal is a read-only signal derived by Computed. It holds
+// subscriptions to its dependencies until Cleanup is called.
+//
+// Example:
+//
+//	total := signals.Computed(func() int { return a.Get() + b.Get() }, a.AsReadonly(), b.AsReadonly())
+//	defer total.Cleanup()
+type ComputedSignal[T any] interface {
+	ReadonlySignal[T]
+
+	// Cleanup unsubscribes from the dependencies: the computed keeps its
+	// last value and no longer recomputes on their changes.
+	Cleanup()
+}
+
+// DirtyChecker is implemented by computed signals. It reports whether the
+// cached value is stale without recomputing it.
+//
+// Example:
+//
+//	if dc, ok := total.(signals.DirtyChecker); ok && dc.IsDirty() {
+//	    log.Println("total will recompute on next Get")
+//	}
+type DirtyChecker interface {
+	// IsDirty reports whether the next Get will recompute.
+	IsDirty() bool
+}
+
+// TryGetter is implemented by computed signals. TryGet returns the value
+// like Get, plus an error if the latest compute panicked and the value is
+// therefore stale.
+//
+// Example:
+//
+//	if tg, ok := total.(signals.TryGetter[int]); ok {
+//	    if v, err := tg.TryGet(); err != nil {
+//	        log.Printf("total is stale (%d): %v", v, err)
+//	    }
+//	}
+type TryGetter[T any] interface {
+	// TryGet returns the current value and the latest compute's panic, if any.
+	TryGet() (T, error)
+}
+
+// DegradedChecker is implemented by computed signals. It reports whether the
+// cached value is stale because the latest compute panicked, without
+// recomputing, so readers can tell a fresh value from a kept one.
+//
+// Example:
+//
+//	total.SubscribeForever(func(v int) { render(v) })
+//	if dc, ok := total.(signals.DegradedChecker); ok && dc.IsDegraded() {
+//	    showBanner("totals may be out of date")
+//	}
+type DegradedChecker interface {
+	// IsDegraded reports whether the latest compute panicked.
+	IsDegraded() bool
+}
+
+// ComputeSetter is implemented by computed signals. SetCompute swaps the
+// derivation without rebuilding the graph, e.g., behind a feature flag.
+//
+// Example:
+//
+//	if cs, ok := price.(signals.ComputeSetter[float64]); ok {
+//	    cs.SetCompute(func() float64 { return base.Get() * discount.Get() })
+//	}
+type ComputeSetter[T any] interface {
+	// SetCompute replaces the compute function and recomputes.
+	SetCompute(fn func() T)
+}
+
+// ComputedChecker is implemented by computed signals. It reports whether
+// compute has ever produced a value, telling a computed that was never
+// evaluated from one that evaluated to the zero value.
+//
+// Example:
+//
+//	if cc, ok := report.(signals.ComputedChecker); ok && !cc.HasComputed() {
+//	    log.Println("report not built yet")
+//	}
+type ComputedChecker interface {
+	// HasComputed reports whether compute has returned at least once.
+	HasComputed() bool
+}
+
+// HistoryReader is implemented by writable signals. With
+// Options.RecordHistory, History returns the values the signal took, like
+// a read-only time-travel debugger; otherwise it returns nil.
+//
+// Example:
+//
+//	cart := signals.NewWithOptions(Cart{}, signals.Options[Cart]{RecordHistory: true})
+//	// ...
+//	if h, ok := cart.(signals.HistoryReader[Cart]); ok {
+//	    for _, r := range h.History() {
+//	        log.Printf("%s: %+v", r.Time.Format(time.StampMicro), r.Value)
+//	    }
+//	}
+type HistoryReader[T any] interface {
+	// History returns the recorded values, oldest first.
+	History() []Record[T]
+}
+
+// DeliveryReader is implemented by writable signals. With
+// Options.TrackDeliveries, Deliveries reports what each subscriber last
+// received; otherwise it returns nil.
+//
+// Example:
+//
+//	for _, d := range cart.(signals.DeliveryReader[Cart]).Deliveries() {
+//	    if !d.Notified || d.Panics > 0 {
+//	        log.Printf("subscriber %d: notified=%v panics=%d", d.ID, d.Notified, d.Panics)
+//	    }
+//	}
+type DeliveryReader[T any] interface {
+	// Deliveries returns the last delivery to each subscriber, in
+	// subscription order.
+	Deliveries() []Delivery[T]
+}
+
+// SafeSetter is implemented by writable signals. SetSafe is like Set but
+// returns the panics recovered from subscribers (as *PanicError) instead of
+// reporting them to OnPanic.
+//
+// Example:
+//
+//	for _, err := range cart.(signals.SafeSetter[Cart]).SetSafe(updated) {
+//	    log.Printf("subscriber failed: %v", err)
+//	}
+type SafeSetter[T any] interface {
+	// SetSafe is like Set but returns the recovered subscriber panics. All
+	// subscribers still run. Returns nil if none panicked.
+	SetSafe(value T) []error
+}
+
+// UpdateGetter is implemented by writable signals. UpdateAndGet is like
+// Update but returns the value held after the transform is committed,
+// under the same lock (atomic fetch-and-update).
+//
+// Example:
+//
+//	ticket := counter.(signals.UpdateGetter[int]).UpdateAndGet(func(v int) int { return v + 1 })
+type UpdateGetter[T any] interface {
+	// UpdateAndGet applies fn atomically and returns the value held after it.
+	UpdateAndGet(fn func(T) T) T
+}
+
+// CompareAndSwapper is implemented by writable signals. CompareAndSwap
+// enables optimistic retry loops without external locking.
+//
+// Example:
+//
+//	cas := balance.(signals.CompareAndSwapper[int])
+//	for {
+//	    cur := balance.Get()
+//	    if cas.CompareAndSwap(cur, cur-amount) {
+//	        break
+//	    }
+//	}
+type CompareAndSwapper[T any] interface {
+	// CompareAndSwap sets the value to new only if the current value equals
+	// old (by Options.Equal, else ==), atomically, and reports whether it
+	// did. Panics for a non-comparable type without Options.Equal.
+	CompareAndSwap(old, new T) bool
+}
+
+// Replacer is implemented by writable signals. ReplaceIf is a conditional
+// write, for state machines whose transitions depend on the current state.
+//
+// Example:
+//
+//	started := job.(signals.Replacer[Job]).ReplaceIf(func(j Job) bool {
+//	    return j.State == Queued
+//	}, running)
+type Replacer[T any] interface {
+	// ReplaceIf sets the value to newValue only if pred accepts the current
+	// value, atomically, and reports whether it did. pred runs under the
+	// write lock, so keep it fast.
+	ReplaceIf(pred func(T) bool, newValue T) bool
+}
+
+// PrioritySubscriber is implemented by writable signals. SubscribeWithPriority
+// orders subscribers, e.g., to update a cache before the subscribers reading it.
+//
+// Example:
+//
+//	cfg.(signals.PrioritySubscriber[Config]).SubscribeWithPriority(ctx, 10, updateCache) // Runs first
+//	cfg.Subscribe(ctx, logFromCache)
+type PrioritySubscriber[T any] interface {
+	// SubscribeWithPriority is like Subscribe, but higher-priority callbacks
+	// run first on each change. Subscribe uses priority 0. Once the signal
+	// has a prioritized callback, equal priorities run in subscription order.
+	SubscribeWithPriority(ctx context.Context, priority int, fn func(T)) Unsubscribe
+}
+
+// MetaSetter is implemented by writable signals. SetWithMeta attaches
+// metadata to a write, e.g., the origin of the change, for MetaSubscriber
+// subscribers.
+//
+// Example:
+//
+//	doc.(signals.MetaSetter[Doc]).SetWithMeta(edited, clientID)
+type MetaSetter[T any] interface {
+	// SetWithMeta is like Set, also delivering meta to SubscribeMeta
+	// subscribers. Other subscribers only receive the value.
+	SetWithMeta(value T, meta any)
+}
+
+// MetaSubscriber is implemented by writable signals. SubscribeMeta
+// receives the metadata a change was written with by MetaSetter.
+//
+// Example:
+//
+//	doc.(signals.MetaSubscriber[Doc]).SubscribeMeta(ctx, func(d Doc, origin any) {
+//	    if origin != self {
+//	        render(d) // Skip our own edits
+//	    }
+//	})
+type MetaSubscriber[T any] interface {
+	// SubscribeMeta is like Subscribe, but fn also receives the metadata
+	// passed to SetWithMeta, or nil for a write without it.
+	SubscribeMeta(ctx context.Context, fn func(T, any)) Unsubscribe
+}
@@ -0,0 +1,136 @@
+package signals
+
+import (
+	"cmp"
+	"slices"
+	"sync"
+	"sync/atomic"
+)
+
+// Changes reach computeds and effects in waves, so that effects only ever
+// observe settled values. A wave first marks everything downstream of the
+// change stale: computeds flag themselves dirty and pass the wave on to
+// their own reactions. It then flushes: computeds settle in height order,
+// each one after every computed it reads, and only then do effects and
+// other callbacks run, each effect once per wave.
+//
+// Without waves, an effect reading two computeds of the same signal (a
+// diamond) would run once per path, the first time with one side stale.
+
+// reaction is notified of a dependency change: a computed, an effect, or
+// an internal callback (reactionFunc).
+type reaction interface {
+	// stale records the reaction in w. Computeds also mark themselves
+	// dirty and pass w on to their own reactions.
+	stale(w *wave)
+
+	// settles reports whether the reaction is a computed. Changes that
+	// reach no computed need no wave: their reactions fire directly.
+	settles() bool
+
+	// fire reacts to a change outside of a wave.
+	fire()
+}
+
+// reactionFunc adapts a callback to reaction. It runs after the wave's
+// computeds have settled, once for every path that reached it.
+type reactionFunc func()
+
+func (f reactionFunc) stale(w *wave) { w.reactions = append(w.reactions, f) }
+func (reactionFunc) settles() bool   { return false }
+func (f reactionFunc) fire()         { f() }
+
+// settler is a computed reached by a wave.
+type settler interface {
+	// settle recomputes the computed if a dependency changed and notifies
+	// its subscribers.
+	settle()
+
+	// height is one more than the height of its highest computed dependency.
+	height() int
+}
+
+// wave is a single propagation of a change. Waves are pooled; they are
+// told apart by id (see claim).
+type wave struct {
+	id        uint64
+	computeds []settler
+	reactions []reaction
+}
+
+var (
+	// waveIDs numbers waves, starting at 1 so a zero stamp is never claimed
+	waveIDs atomic.Uint64
+
+	wavePool = sync.Pool{New: func() any { return new(wave) }}
+)
+
+// claim reports whether this is the first time w reaches the node owning
+// stamp, recording w in it.
+func (w *wave) claim(stamp *atomic.Uint64) bool {
+	return stamp.Swap(w.id) != w.id
+}
+
+// propagate notifies reactions of a change, in a wave if any of them is a
+// computed. Each reaction runs with a recover that hands panics to
+// onPanic, along with sink.
+func propagate(reactions []reaction, onPanic func(r any, sink *[]error), sink *[]error) {
+	if !slices.ContainsFunc(reactions, reaction.settles) {
+		for _, r := range reactions {
+			func() {
+				defer recoverReaction(onPanic, sink)
+				r.fire()
+			}()
+		}
+		return
+	}
+
+	w := wavePool.Get().(*wave)
+	w.id = waveIDs.Add(1)
+	for _, r := range reactions {
+		r.stale(w)
+	}
+	w.flush(onPanic, sink)
+
+	clear(w.computeds)
+	clear(w.reactions)
+	w.computeds, w.reactions = w.computeds[:0], w.reactions[:0]
+	wavePool.Put(w)
+}
+
+// flush settles the wave's computeds, lowest first, then runs the rest.
+func (w *wave) flush(onPanic func(r any, sink *[]error), sink *[]error) {
+	slices.SortStableFunc(w.computeds, func(a, b settler) int {
+		return cmp.Compare(a.height(), b.height())
+	})
+	for _, c := range w.computeds {
+		func() {
+			defer recoverReaction(onPanic, sink)
+			c.settle()
+		}()
+	}
+	for _, r := range w.reactions {
+		func() {
+			defer recoverReaction(onPanic, sink)
+			r.fire()
+		}()
+	}
+}
+
+// recoverReaction recovers a panicking reaction. Must be called via defer.
+func recoverReaction(onPanic func(r any, sink *[]error), sink *[]error) {
+	if r := recover(); r != nil {
+		onPanic(r, sink)
+	}
+}
+
+// dependencyHeight returns the height of a computed with deps.
+func dependencyHeight(deps []any) int {
+	h := 0
+	for _, dep := range deps {
+		if s, ok := dependencyKey(dep).(settler); ok {
+			h = max(h, s.height())
+		}
+	}
+	return h + 1
+}
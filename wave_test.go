@@ -0,0 +1,179 @@
+package signals
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+	"testing"
+)
+
+// chainAndBranch builds a -> b -> c (chain) and a -> d (branch), logging
+// the order of computes.
+func chainAndBranch() (a Signal[int], c, d ReadonlySignal[int], computes *[]string) {
+	computes = new([]string)
+	a = New(1)
+	b := Computed(func() int {
+		*computes = append(*computes, "b")
+		return a.Get() * 2
+	}, a.AsReadonly())
+	c = Computed(func() int {
+		*computes = append(*computes, "c")
+		return b.Get() + 1
+	}, b)
+	d = Computed(func() int {
+		*computes = append(*computes, "d")
+		return a.Get() * 10
+	}, a.AsReadonly())
+	return a, c, d, computes
+}
+
+// TestWave_EffectSeesSettledChainAndBranch verifies an effect below a chain
+// and a branch of the same signal runs once per Set, with settled values
+func TestWave_EffectSeesSettledChainAndBranch(t *testing.T) {
+	a, c, d, _ := chainAndBranch()
+
+	var seen []string
+	eff := Effect(func() {
+		seen = append(seen, fmt.Sprintf("a=%d c=%d d=%d", a.Get(), c.Get(), d.Get()))
+	}, a.AsReadonly(), c, d)
+	defer eff.Stop()
+
+	a.Set(2)
+	a.Set(3)
+
+	want := []string{"a=1 c=3 d=10", "a=2 c=5 d=20", "a=3 c=7 d=30"}
+	if !slices.Equal(seen, want) {
+		t.Errorf("effect saw %q, want %q", seen, want)
+	}
+}
+
+// TestWave_ComputesInDependencyOrder verifies computeds settle after the
+// computeds they read, each once per Set
+func TestWave_ComputesInDependencyOrder(t *testing.T) {
+	a, c, d, computes := chainAndBranch()
+
+	sum := Computed(func() int {
+		*computes = append(*computes, "sum")
+		return c.Get() + d.Get()
+	}, c, d)
+
+	var sums []int
+	eff := Effect(func() { sums = append(sums, sum.Get()) }, sum)
+	defer eff.Stop()
+
+	*computes = nil
+	a.Set(2)
+
+	if len(*computes) != 4 || (*computes)[3] != "sum" {
+		t.Fatalf("computes = %v, want b, c, d in any valid order, then sum", *computes)
+	}
+	if b, c := slices.Index(*computes, "b"), slices.Index(*computes, "c"); b < 0 || c < b {
+		t.Errorf("computes = %v, want b before c", *computes)
+	}
+	if want := []int{13, 25}; !slices.Equal(sums, want) {
+		t.Errorf("sums = %v, want %v", sums, want)
+	}
+}
+
+// TestWave_SubscribersSeeSettledValues verifies a computed's subscribers
+// are notified once, after the computeds it reads have settled
+func TestWave_SubscribersSeeSettledValues(t *testing.T) {
+	a, c, d, _ := chainAndBranch()
+	sum := Computed(func() int { return c.Get() + d.Get() }, c, d)
+
+	var got []int
+	sum.SubscribeForever(func(v int) { got = append(got, v) })
+	sum.Get()
+
+	a.Set(2)
+	a.Set(3)
+
+	if want := []int{25, 37}; !slices.Equal(got, want) {
+		t.Errorf("notifications = %v, want %v", got, want)
+	}
+}
+
+// TestWave_UnchangedBranchSkipped verifies a computed whose dependencies
+// settled to the same versions is not recomputed in a wave
+func TestWave_UnchangedBranchSkipped(t *testing.T) {
+	a, c, d, computes := chainAndBranch()
+	c.Get()
+	d.Get()
+
+	// A computed reached twice in the same wave (through c and d) settles once
+	joined := Computed(func() int {
+		*computes = append(*computes, "joined")
+		return c.Get() - d.Get()
+	}, c, d)
+	joined.SubscribeForever(func(int) {})
+	joined.Get()
+
+	*computes = nil
+	a.Set(5)
+
+	if n := len(slices.DeleteFunc(slices.Clone(*computes), func(s string) bool { return s != "joined" })); n != 1 {
+		t.Errorf("joined computed %d times, want 1 (computes = %v)", n, *computes)
+	}
+	if got := joined.Get(); got != 11-50 {
+		t.Errorf("joined.Get() = %d, want %d", got, 11-50)
+	}
+}
+
+// TestWave_RecomputeSettlesDownstream verifies Recompute propagates to
+// downstream computeds and effects in order
+func TestWave_RecomputeSettlesDownstream(t *testing.T) {
+	external := 1
+	base := Computed(func() int { return external })
+	double := Computed(func() int { return base.Get() * 2 }, base)
+
+	var seen []string
+	eff := Effect(func() {
+		seen = append(seen, fmt.Sprintf("%d/%d", base.Get(), double.Get()))
+	}, base, double)
+	defer eff.Stop()
+
+	external = 4
+	base.(*computed[int]).Recompute()
+
+	if want := []string{"1/2", "4/8"}; !slices.Equal(seen, want) {
+		t.Errorf("effect saw %q, want %q", seen, want)
+	}
+}
+
+// TestWave_ConcurrentSets verifies concurrent waves leave the graph
+// consistent
+func TestWave_ConcurrentSets(t *testing.T) {
+	a := New(0)
+	b := Computed(func() int { return a.Get() * 2 }, a.AsReadonly())
+	c := Computed(func() int { return b.Get() + 1 }, b)
+
+	var mu sync.Mutex
+	var last int
+	eff := Effect(func() {
+		v := c.Get()
+		mu.Lock()
+		last = v
+		mu.Unlock()
+	}, a.AsReadonly(), c)
+	defer eff.Stop()
+
+	var wg sync.WaitGroup
+	for i := range 50 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			a.Set(i)
+		}()
+	}
+	wg.Wait()
+
+	if got, want := c.Get(), a.Get()*2+1; got != want {
+		t.Errorf("c.Get() = %d, want %d", got, want)
+	}
+	a.Set(100)
+	mu.Lock()
+	defer mu.Unlock()
+	if last != 201 {
+		t.Errorf("effect saw %d after final Set, want 201", last)
+	}
+}
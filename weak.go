@@ -0,0 +1,68 @@
+package signals
+
+import (
+	"runtime"
+	"sync"
+	"weak"
+)
+
+// WeakSubscribe registers a callback whose lifetime is tied to an owner object.
+//
+// The subscription holds the owner only weakly: once the owner becomes
+// unreachable and is garbage collected, the subscription is removed
+// automatically. The callback receives the owner as a parameter so it does
+// not need to capture it (capturing the owner in fn would keep it alive
+// forever and defeat the purpose).
+//
+// Removal is best-effort and happens on the garbage collector's schedule,
+// so it may be delayed arbitrarily. Calling the returned Unsubscribe is
+// still the preferred, deterministic way to clean up.
+//
+// Example:
+//
+//	type Widget struct{ label string }
+//
+//	w := &Widget{}
+//	signals.WeakSubscribe(count.AsReadonly(), w, func(w *Widget, v int) {
+//	    w.label = fmt.Sprint(v)
+//	})
+//	// No Unsubscribe needed: dropping w eventually removes the subscription.
+func WeakSubscribe[T, O any](s ReadonlySignal[T], owner *O, fn func(owner *O, value T)) Unsubscribe {
+	ref := weak.Make(owner)
+
+	unsub := s.SubscribeForever(func(v T) {
+		// Owner may already be collected while cleanup is pending
+		if o := ref.Value(); o != nil {
+			fn(o, v)
+		}
+	})
+
+	var once sync.Once
+	release := func() { once.Do(unsub) }
+
+	// The cleanup must not reference owner, otherwise it is never reachable
+	cleanup := runtime.AddCleanup(owner, func(release func()) { release() }, release)
+
+	return func() {
+		cleanup.Stop()
+		release()
+	}
+}
+
+// collectableComputed is the handle of a computed created with
+// Options.WeakDependencies. The dependency graph only references the
+// embedded computed, so the handle becomes unreachable once the caller
+// drops it, and its cleanup unsubscribes the computed.
+type collectableComputed[T any] struct {
+	*computed[T]
+}
+
+// collectable returns a handle to c that calls c.Cleanup once it is
+// garbage collected.
+func collectable[T any](c *computed[T]) ComputedSignal[T] {
+	h := &collectableComputed[T]{computed: c}
+
+	// The cleanup must not reference h, otherwise it is never reachable
+	runtime.AddCleanup(h, func(c *computed[T]) { c.Cleanup() }, c)
+	return h
+}
@@ -0,0 +1,138 @@
+package signals
+
+import (
+	"runtime"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// subscriberCount returns the number of registered subscribers of a signal.
+func subscriberCount[T any](s Signal[T]) int {
+	impl := s.(*signal[T])
+	impl.mu.RLock()
+	defer impl.mu.RUnlock()
+	return len(impl.subscribers)
+}
+
+type weakOwner struct {
+	calls atomic.Int32
+	_     [16]byte // avoid tiny-allocator batching, which delays collection
+}
+
+// TestWeakSubscribe_DeliversWhileOwnerAlive verifies the owner receives notifications
+func TestWeakSubscribe_DeliversWhileOwnerAlive(t *testing.T) {
+	sig := New(0)
+	owner := &weakOwner{}
+
+	unsub := WeakSubscribe(sig.AsReadonly(), owner, func(o *weakOwner, _ int) {
+		o.calls.Add(1)
+	})
+	defer unsub()
+
+	sig.Set(1)
+	sig.Set(2)
+
+	if got := owner.calls.Load(); got != 2 {
+		t.Errorf("owner received %d notifications, want 2", got)
+	}
+	runtime.KeepAlive(owner)
+}
+
+// TestWeakSubscribe_RemovedAfterOwnerCollected verifies GC of the owner removes the subscription
+func TestWeakSubscribe_RemovedAfterOwnerCollected(t *testing.T) {
+	sig := New(0)
+
+	func() {
+		owner := &weakOwner{}
+		WeakSubscribe(sig.AsReadonly(), owner, func(o *weakOwner, _ int) {
+			o.calls.Add(1)
+		})
+	}()
+
+	if got := subscriberCount(sig); got != 1 {
+		t.Fatalf("subscriber count = %d, want 1", got)
+	}
+
+	deadline := time.Now().Add(2 * time.Second)
+	for subscriberCount(sig) != 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("subscription was not removed after owner became unreachable")
+		}
+		runtime.GC()
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// Set after removal must not panic
+	sig.Set(1)
+}
+
+// TestWeakSubscribe_ManualUnsubscribe verifies Unsubscribe works before the owner is collected
+func TestWeakSubscribe_ManualUnsubscribe(t *testing.T) {
+	sig := New(0)
+	owner := &weakOwner{}
+
+	unsub := WeakSubscribe(sig.AsReadonly(), owner, func(o *weakOwner, _ int) {
+		o.calls.Add(1)
+	})
+	unsub()
+	unsub() // Safe to call twice
+
+	sig.Set(1)
+
+	if got := owner.calls.Load(); got != 0 {
+		t.Errorf("owner received %d notifications after Unsubscribe, want 0", got)
+	}
+	if got := subscriberCount(sig); got != 0 {
+		t.Errorf("subscriber count = %d, want 0", got)
+	}
+	runtime.KeepAlive(owner)
+}
+
+// TestComputed_WeakDependenciesCollected verifies a dropped computed with WeakDependencies unsubscribes from its source
+func TestComputed_WeakDependenciesCollected(t *testing.T) {
+	sig := New(2)
+
+	func() {
+		doubled := ComputedWithOptions(func() int { return sig.Get() * 2 },
+			Options[int]{WeakDependencies: true}, sig.AsReadonly())
+		if got := doubled.Get(); got != 4 {
+			t.Errorf("Get() = %d, want 4", got)
+		}
+	}()
+
+	if got := sig.Dependents().Computed; got != 1 {
+		t.Fatalf("Dependents().Computed = %d, want 1", got)
+	}
+
+	deadline := time.Now().Add(2 * time.Second)
+	for sig.Dependents().Computed != 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("dependency subscription was not removed after the computed became unreachable")
+		}
+		runtime.GC()
+		time.Sleep(10 * time.Millisecond)
+	}
+	sig.Set(3) // Must not reach the collected computed
+}
+
+// TestComputed_WeakDependenciesKeptByDependents verifies a weak computed stays subscribed while an effect depends on it
+func TestComputed_WeakDependenciesKeptByDependents(t *testing.T) {
+	sig := New(1)
+	var seen atomic.Int64
+
+	eff := func() EffectRef {
+		doubled := ComputedWithOptions(func() int { return sig.Get() * 2 },
+			Options[int]{WeakDependencies: true}, sig.AsReadonly())
+		return Effect(func() { seen.Store(int64(doubled.Get())) }, doubled)
+	}()
+	defer eff.Stop()
+
+	for range 3 {
+		runtime.GC()
+	}
+	sig.Set(5)
+	if got := seen.Load(); got != 10 {
+		t.Errorf("effect saw %d, want 10 (computed still subscribed)", got)
+	}
+}
@@ -0,0 +1,172 @@
+package signals
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// ZipBufferSize is the number of values Zip buffers per source while
+// waiting for the other one. Beyond it, the oldest buffered value is dropped.
+const ZipBufferSize = 64
+
+// Pair holds one value of each source of a Zip.
+type Pair[A, B any] struct {
+	A A
+	B B
+}
+
+// zipped is the internal implementation of Zip.
+type zipped[A, B any] struct {
+	// pending holds the values of each source not paired yet, oldest first
+	pendingA []A
+	pendingB []B
+
+	// mu protects pendingA and pendingB, and orders the commits to pairs
+	mu sync.Mutex
+
+	// pairs holds the latest pair and notifies subscribers of new ones
+	pairs *signal[Pair[A, B]]
+
+	// unsubscribes holds cleanup functions for the sources
+	unsubscribes []Unsubscribe
+
+	// stopOnce makes Stop idempotent
+	stopOnce sync.Once
+}
+
+// Zip derives a signal pairing the changes of a and b in lockstep: the
+// n-th change of a is paired with the n-th change of b, and a pair is
+// emitted only once both have changed since the previous one. Unlike a
+// computed reading both, a change to one side alone emits nothing.
+//
+// Changes of the side that runs ahead are buffered until the other side
+// catches up, up to ZipBufferSize of them; when the buffer is full, the
+// oldest buffered change is dropped to make room.
+//
+// Get returns the latest pair, initially the current values of a and b.
+// Stop unsubscribes from the sources and drops the buffered changes.
+// Optional Options (only the first is used) configure the derived signal,
+// e.g., OnPanic for its subscribers.
+//
+// Example:
+//
+//	requests := signals.New(Request{})
+//	responses := signals.New(Response{})
+//	exchanges := signals.Zip(requests.AsReadonly(), responses.AsReadonly())
+//	defer exchanges.Stop()
+//
+//	exchanges.SubscribeForever(func(p signals.Pair[Request, Response]) {
+//	    logExchange(p.A, p.B) // Each request with its response
+//	})
+func Zip[A, B any](a ReadonlySignal[A], b ReadonlySignal[B], opts ...Options[Pair[A, B]]) CombinedSignal[Pair[A, B]] {
+	z := &zipped[A, B]{}
+	z.pairs = newSignal(Pair[A, B]{A: a.Get(), B: b.Get()}, firstOptions(opts))
+	z.unsubscribes = []Unsubscribe{
+		a.SubscribeForever(z.pushA),
+		b.SubscribeForever(z.pushB),
+	}
+	return z
+}
+
+// pushA buffers a change of a and emits the pairs it completes.
+func (z *zipped[A, B]) pushA(v A) {
+	z.mu.Lock()
+	z.pendingA = appendBounded(z.pendingA, v)
+	notifications := z.commit(z.takePairs())
+	z.mu.Unlock()
+	for _, notify := range notifications {
+		notify()
+	}
+}
+
+// pushB buffers a change of b and emits the pairs it completes.
+func (z *zipped[A, B]) pushB(v B) {
+	z.mu.Lock()
+	z.pendingB = appendBounded(z.pendingB, v)
+	notifications := z.commit(z.takePairs())
+	z.mu.Unlock()
+	for _, notify := range notifications {
+		notify()
+	}
+}
+
+// takePairs removes the buffered changes both sides have. Caller must hold mu.
+func (z *zipped[A, B]) takePairs() []Pair[A, B] {
+	n := min(len(z.pendingA), len(z.pendingB))
+	if n == 0 {
+		return nil
+	}
+	pairs := make([]Pair[A, B], n)
+	for i := range pairs {
+		pairs[i] = Pair[A, B]{A: z.pendingA[i], B: z.pendingB[i]}
+	}
+	z.pendingA = z.pendingA[n:]
+	z.pendingB = z.pendingB[n:]
+	return pairs
+}
+
+// commit writes pairs in order and returns their notifications, to send
+// once mu is released. Committing under mu queues the notifications of
+// concurrent pushes in pair order, while sending them unlocked lets
+// subscribers write to the sources. Caller must hold mu.
+func (z *zipped[A, B]) commit(pairs []Pair[A, B]) []func() {
+	s := z.pairs
+	notifications := make([]func(), 0, len(pairs))
+	for _, p := range pairs {
+		v, w, err := s.commitUpdate(func(Pair[A, B]) (Pair[A, B], bool) { return p, true }, nil)
+		notifications = append(notifications, func() {
+			if err != nil {
+				if !errors.Is(err, ErrSignalClosed) {
+					s.reject(v, err)
+				}
+				return
+			}
+			s.notify(w.reactions, w.callbacks, w.deliver, v, nil, nil)
+		})
+	}
+	return notifications
+}
+
+// appendBounded appends v, dropping the oldest value beyond ZipBufferSize.
+func appendBounded[T any](buf []T, v T) []T {
+	buf = append(buf, v)
+	if len(buf) > ZipBufferSize {
+		var zero T
+		buf[0] = zero // Don't keep the dropped value alive
+		buf = buf[1:]
+	}
+	return buf
+}
+
+// Get returns the latest pair.
+func (z *zipped[A, B]) Get() Pair[A, B] {
+	return z.pairs.Get()
+}
+
+// Subscribe registers a callback that receives each new pair.
+func (z *zipped[A, B]) Subscribe(ctx context.Context, fn func(Pair[A, B])) Unsubscribe {
+	return z.pairs.Subscribe(ctx, fn)
+}
+
+// SubscribeForever registers a callback that never auto-cancels.
+func (z *zipped[A, B]) SubscribeForever(fn func(Pair[A, B])) Unsubscribe {
+	return z.pairs.SubscribeForever(fn)
+}
+
+// subscribeDependent registers a downstream computed or effect as a dependent.
+func (z *zipped[A, B]) subscribeDependent(kind DependentKind, r reaction) Unsubscribe {
+	return z.pairs.subscribeDependent(kind, r)
+}
+
+// Stop unsubscribes from the sources and drops the buffered changes.
+func (z *zipped[A, B]) Stop() {
+	z.stopOnce.Do(func() {
+		for _, unsub := range z.unsubscribes {
+			unsub()
+		}
+		z.mu.Lock()
+		z.pendingA, z.pendingB = nil, nil
+		z.mu.Unlock()
+	})
+}
@@ -0,0 +1,134 @@
+package signals
+
+import (
+	"slices"
+	"sync"
+	"testing"
+)
+
+// TestZip_PairsInLockstep verifies pairs are emitted only once both sources advanced, index-wise
+func TestZip_PairsInLockstep(t *testing.T) {
+	a, b := New(0), New("")
+	z := Zip(a.AsReadonly(), b.AsReadonly())
+	defer z.Stop()
+
+	var got []Pair[int, string]
+	z.SubscribeForever(func(p Pair[int, string]) { got = append(got, p) })
+
+	a.Set(1)
+	if len(got) != 0 {
+		t.Fatalf("emitted %v after a alone, want nothing", got)
+	}
+	b.Set("x")
+	a.Set(2)
+	a.Set(3) // a runs ahead
+	b.Set("y")
+	b.Set("z")
+
+	want := []Pair[int, string]{{1, "x"}, {2, "y"}, {3, "z"}}
+	if !slices.Equal(got, want) {
+		t.Errorf("pairs = %v, want %v", got, want)
+	}
+	if p := z.Get(); p != want[2] {
+		t.Errorf("Get() = %v, want %v", p, want[2])
+	}
+}
+
+// TestZip_InitialValue verifies Get starts with the sources' current values
+func TestZip_InitialValue(t *testing.T) {
+	a, b := New(1), New(true)
+	z := Zip(a.AsReadonly(), b.AsReadonly())
+	defer z.Stop()
+
+	if p := z.Get(); p != (Pair[int, bool]{1, true}) {
+		t.Errorf("Get() = %v, want {1 true}", p)
+	}
+}
+
+// TestZip_BufferOverflow verifies the oldest buffered changes are dropped beyond ZipBufferSize
+func TestZip_BufferOverflow(t *testing.T) {
+	a, b := New(0), New(0)
+	z := Zip(a.AsReadonly(), b.AsReadonly())
+	defer z.Stop()
+
+	for i := 1; i <= ZipBufferSize+10; i++ {
+		a.Set(i)
+	}
+	b.Set(100)
+
+	if p := z.Get(); p.A != 11 || p.B != 100 {
+		t.Errorf("Get() = %v, want {11 100} (first 10 changes of a dropped)", p)
+	}
+}
+
+// TestZip_Stop verifies no pairs are emitted after Stop
+func TestZip_Stop(t *testing.T) {
+	a, b := New(0), New(0)
+	z := Zip(a.AsReadonly(), b.AsReadonly())
+
+	var count int
+	z.SubscribeForever(func(Pair[int, int]) { count++ })
+	a.Set(1)
+	z.Stop()
+	b.Set(1)
+
+	if count != 0 {
+		t.Errorf("emitted %d pairs after Stop, want 0", count)
+	}
+	z.Stop() // Idempotent
+}
+
+// TestZip_ConcurrentSourcesInOrder verifies pairs completed by writers on different goroutines are emitted in index order
+func TestZip_ConcurrentSourcesInOrder(t *testing.T) {
+	for range 20 {
+		a, b := New(0), New(0)
+		z := Zip(a.AsReadonly(), b.AsReadonly())
+		var got []Pair[int, int]
+		z.SubscribeForever(func(p Pair[int, int]) { got = append(got, p) }) // Delivery is serialized
+
+		var wg sync.WaitGroup
+		wg.Go(func() {
+			for i := 1; i <= ZipBufferSize; i++ {
+				a.Set(i)
+			}
+		})
+		wg.Go(func() {
+			for i := 1; i <= ZipBufferSize; i++ {
+				b.Set(i)
+			}
+		})
+		wg.Wait()
+		z.Stop()
+
+		if len(got) != ZipBufferSize {
+			t.Fatalf("emitted %d pairs, want %d", len(got), ZipBufferSize)
+		}
+		for i, p := range got {
+			if p.A != i+1 || p.B != i+1 {
+				t.Fatalf("pair %d = %+v, want {%d %d}", i, p, i+1, i+1)
+			}
+		}
+	}
+}
+
+// TestZip_SubscriberWritesSource verifies a subscriber may write to a source while a pair is delivered
+func TestZip_SubscriberWritesSource(t *testing.T) {
+	a, b := New(0), New(0)
+	z := Zip(a.AsReadonly(), b.AsReadonly())
+	defer z.Stop()
+	var got []Pair[int, int]
+	z.SubscribeForever(func(p Pair[int, int]) {
+		got = append(got, p)
+		if p.A == 1 {
+			a.Set(2)
+		}
+	})
+
+	a.Set(1)
+	b.Set(1)
+	b.Set(2)
+
+	if want := []Pair[int, int]{{1, 1}, {2, 2}}; !slices.Equal(got, want) {
+		t.Errorf("emitted %v, want %v", got, want)
+	}
+}